@@ -38,6 +38,7 @@ import (
 	schedulerfeatures "github.com/gardener/gardener/pkg/scheduler/features"
 	"github.com/gardener/gardener/pkg/server"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -270,7 +271,7 @@ func (g *GardenerScheduler) startScheduler(ctx context.Context) {
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(
-		scheduler.ControllerWorkerSum,
+		[]*prometheus.Desc{scheduler.ControllerWorkerSum},
 		scheduler.ScrapeFailures,
 		shootScheduler,
 	)
@@ -14,11 +14,25 @@
 
 package controller
 
-import "github.com/gardener/gardener/pkg/extensions"
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/extensions"
+)
 
 // Cluster contains the decoded resources of Gardener's extension Cluster resource.
 type Cluster = extensions.Cluster
 
+// TimeUntilLeaseExpiry returns the remaining duration until the gardenlet's lease for the given cluster expires,
+// relative to the given time. The second return value indicates whether a lease is maintained at all. If the lease
+// has already expired, the returned duration is zero or negative.
+func TimeUntilLeaseExpiry(cluster *Cluster, now time.Time) (time.Duration, bool) {
+	if cluster == nil || cluster.LeaseExpiration == nil {
+		return 0, false
+	}
+	return cluster.LeaseExpiration.Time.Sub(now), true
+}
+
 var (
 	// NewGardenDecoder returns a new Garden API decoder.
 	NewGardenDecoder = extensions.NewGardenDecoder
@@ -0,0 +1,65 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Cluster", func() {
+	Describe("#TimeUntilLeaseExpiry", func() {
+		var (
+			now     time.Time
+			cluster *Cluster
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			cluster = &Cluster{}
+		})
+
+		It("should return the remaining duration if the lease expires in the future", func() {
+			leaseExpiration := metav1.NewTime(now.Add(time.Hour))
+			cluster.LeaseExpiration = &leaseExpiration
+
+			remaining, ok := TimeUntilLeaseExpiry(cluster, now)
+			Expect(ok).To(BeTrue())
+			Expect(remaining).To(Equal(time.Hour))
+		})
+
+		It("should return a negative duration if the lease has already expired", func() {
+			leaseExpiration := metav1.NewTime(now.Add(-time.Minute))
+			cluster.LeaseExpiration = &leaseExpiration
+
+			remaining, ok := TimeUntilLeaseExpiry(cluster, now)
+			Expect(ok).To(BeTrue())
+			Expect(remaining).To(Equal(-time.Minute))
+		})
+
+		It("should report that no lease is maintained if the lease expiration is not set", func() {
+			_, ok := TimeUntilLeaseExpiry(cluster, now)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should report that no lease is maintained for a nil cluster", func() {
+			_, ok := TimeUntilLeaseExpiry(nil, now)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Drainer lets a manager wait briefly for in-flight operations, e.g. actuator reconciles, to reach a safe point
+// before shutting down. Operations register via Begin and deregister via the returned done function, and should
+// derive their context via WithShutdown so that they observe the shutdown as a context cancellation. Calling
+// Drain cancels all derived contexts and waits until all registered operations have finished or the given grace
+// period has elapsed.
+type Drainer struct {
+	logger logr.Logger
+
+	mutex    sync.Mutex
+	inFlight int
+	idle     chan struct{}
+	shutdown chan struct{}
+	draining bool
+}
+
+// NewDrainer creates a new Drainer that logs to the given logger.
+func NewDrainer(logger logr.Logger) *Drainer {
+	return &Drainer{
+		logger:   logger,
+		shutdown: make(chan struct{}),
+	}
+}
+
+// Begin registers a new in-flight operation and returns a function that must be called when the operation has
+// finished. The returned function may be called multiple times, only the first call takes effect.
+func (d *Drainer) Begin() func() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			d.mutex.Lock()
+			defer d.mutex.Unlock()
+			d.inFlight--
+			if d.inFlight == 0 && d.idle != nil {
+				close(d.idle)
+				d.idle = nil
+			}
+		})
+	}
+}
+
+// ShuttingDown returns a channel that is closed when Drain has been called. Long-running operations can select
+// on it in addition to their context to detect the shutdown.
+func (d *Drainer) ShuttingDown() <-chan struct{} {
+	return d.shutdown
+}
+
+// WithShutdown returns a context derived from the given context that is additionally cancelled when Drain is
+// called. The returned cancel function must be called to release resources once the operation has finished.
+func (d *Drainer) WithShutdown(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-d.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Drain marks the drainer as shutting down, cancelling all contexts derived via WithShutdown, and blocks until
+// all in-flight operations have finished or the given grace period has elapsed. It returns true if all
+// operations finished in time.
+func (d *Drainer) Drain(gracePeriod time.Duration) bool {
+	d.mutex.Lock()
+	if !d.draining {
+		d.draining = true
+		close(d.shutdown)
+	}
+	if d.inFlight == 0 {
+		d.mutex.Unlock()
+		return true
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	d.mutex.Unlock()
+
+	select {
+	case <-idle:
+		return true
+	case <-time.After(gracePeriod):
+		d.logger.Info("Timed out waiting for in-flight operations to finish", "gracePeriod", gracePeriod)
+		return false
+	}
+}
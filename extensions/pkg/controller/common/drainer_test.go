@@ -0,0 +1,94 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/common"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drainer", func() {
+	var drainer *Drainer
+
+	BeforeEach(func() {
+		drainer = NewDrainer(logr.Discard())
+	})
+
+	Describe("#Drain", func() {
+		It("should return true immediately if there are no in-flight operations", func() {
+			Expect(drainer.Drain(time.Minute)).To(BeTrue())
+		})
+
+		It("should return true if all in-flight operations finish within the grace period", func() {
+			done := drainer.Begin()
+
+			finished := make(chan bool)
+			go func() {
+				finished <- drainer.Drain(time.Minute)
+			}()
+
+			Consistently(finished).ShouldNot(Receive())
+			done()
+			Eventually(finished).Should(Receive(BeTrue()))
+		})
+
+		It("should return false if an in-flight operation does not finish within the grace period", func() {
+			done := drainer.Begin()
+			defer done()
+
+			Expect(drainer.Drain(10 * time.Millisecond)).To(BeFalse())
+		})
+
+		It("should tolerate multiple calls to the done function", func() {
+			done := drainer.Begin()
+			done()
+			done()
+
+			Expect(drainer.Drain(time.Minute)).To(BeTrue())
+		})
+	})
+
+	Describe("#WithShutdown", func() {
+		It("should cancel derived contexts when draining", func() {
+			ctx, cancel := drainer.WithShutdown(context.Background())
+			defer cancel()
+
+			Expect(drainer.Drain(time.Minute)).To(BeTrue())
+			Eventually(ctx.Done()).Should(BeClosed())
+		})
+
+		It("should not cancel derived contexts before draining", func() {
+			ctx, cancel := drainer.WithShutdown(context.Background())
+			defer cancel()
+
+			Consistently(ctx.Done()).ShouldNot(BeClosed())
+		})
+	})
+
+	Describe("#ShuttingDown", func() {
+		It("should close the shutdown channel when draining", func() {
+			Consistently(drainer.ShuttingDown()).ShouldNot(BeClosed())
+
+			Expect(drainer.Drain(time.Minute)).To(BeTrue())
+			Expect(drainer.ShuttingDown()).To(BeClosed())
+		})
+	})
+})
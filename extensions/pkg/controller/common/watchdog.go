@@ -0,0 +1,156 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+
+	"github.com/go-logr/logr"
+)
+
+// maxFailedLeaderChecks is the number of consecutive failed leader checks after which the dependent
+// contexts are cancelled. Transient resolver errors (e.g. timeouts or SERVFAIL responses) below this
+// threshold are retried at the next interval instead of aborting in-flight operations.
+const maxFailedLeaderChecks = 3
+
+// Resolver looks up DNS TXT records. *net.Resolver implements this interface.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Watchdog periodically checks that this replica still owns its shoot by resolving the owner DNS record and
+// comparing the record value with the expected owner ID. If the check fails, the given cancel function is called
+// so that dependent work can be stopped before a different owner takes over.
+type Watchdog struct {
+	resolver  Resolver
+	ownerName string
+	ownerID   string
+	interval  time.Duration
+	cancel    context.CancelFunc
+	logger    logr.Logger
+
+	lastCheckMutex sync.Mutex
+	lastCheck      time.Time
+}
+
+// NewWatchdog creates a new Watchdog that resolves the owner DNS record with the given name via the given resolver
+// every interval and calls the given cancel function when the record no longer contains the given owner ID.
+func NewWatchdog(resolver Resolver, ownerName, ownerID string, interval time.Duration, cancel context.CancelFunc, logger logr.Logger) *Watchdog {
+	return &Watchdog{
+		resolver:  resolver,
+		ownerName: ownerName,
+		ownerID:   ownerID,
+		interval:  interval,
+		cancel:    cancel,
+		logger:    logger,
+	}
+}
+
+// NewWatchdogForCluster creates a new Watchdog for the given cluster. The owner DNS record name is derived from
+// the cluster's shoot via extensionscontroller.OwnerDNSName instead of being passed in by the caller, so that all
+// watchdogs agree on the canonical record name. An error is returned if the owner DNS name cannot be derived.
+func NewWatchdogForCluster(resolver Resolver, cluster *extensionscontroller.Cluster, ownerID string, interval time.Duration, cancel context.CancelFunc, logger logr.Logger) (*Watchdog, error) {
+	ownerName, err := extensionscontroller.OwnerDNSName(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewWatchdog(resolver, ownerName, ownerID, interval, cancel, logger), nil
+}
+
+// Start launches a goroutine that performs the leader check every interval until the given context is cancelled.
+func (w *Watchdog) Start(ctx context.Context) {
+	go func() {
+		var failedChecks int
+
+		for {
+			// Check the context before performing the leader check so that an already cancelled
+			// context results in an immediate return without a DNS lookup.
+			if ctx.Err() != nil {
+				return
+			}
+
+			owned, err := w.leaderCheck(ctx)
+
+			// If the watchdog context was cancelled while the lookup was in flight, this is a regular
+			// shutdown, not a leadership change, so return without cancelling the dependent contexts.
+			if ctx.Err() != nil {
+				return
+			}
+
+			switch {
+			case err != nil:
+				// Resolver errors are usually transient, so only cancel the dependent contexts after
+				// several consecutive failures.
+				failedChecks++
+				if failedChecks >= maxFailedLeaderChecks {
+					w.logger.Error(err, "Leader check failed consecutively, cancelling dependent contexts", "ownerName", w.ownerName, "failedChecks", failedChecks)
+					w.cancel()
+					return
+				}
+				w.logger.Error(err, "Leader check failed, retrying", "ownerName", w.ownerName, "failedChecks", failedChecks)
+
+			case !owned:
+				// A confirmed ownership change means a different replica took over, so dependent work
+				// must be stopped immediately.
+				w.logger.Info("Owner changed, cancelling dependent contexts", "ownerName", w.ownerName, "ownerID", w.ownerID)
+				w.cancel()
+				return
+
+			default:
+				failedChecks = 0
+				w.lastCheckMutex.Lock()
+				w.lastCheck = time.Now()
+				w.lastCheckMutex.Unlock()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.interval):
+			}
+		}
+	}()
+}
+
+// Healthy reports whether the watchdog goroutine performed a successful leader check within the given maximum
+// staleness. It can be used as a liveness signal to detect a watchdog goroutine that has stalled or died.
+func (w *Watchdog) Healthy(maxStaleness time.Duration) bool {
+	w.lastCheckMutex.Lock()
+	defer w.lastCheckMutex.Unlock()
+
+	return !w.lastCheck.IsZero() && time.Since(w.lastCheck) <= maxStaleness
+}
+
+// leaderCheck resolves the owner DNS record and reports whether it still contains the expected owner ID.
+// The order of the returned TXT records is not guaranteed, so all of them are considered. A non-nil error
+// indicates that the lookup itself failed and the ownership status could not be determined.
+func (w *Watchdog) leaderCheck(ctx context.Context) (bool, error) {
+	owners, err := w.resolver.LookupTXT(ctx, w.ownerName)
+	if err != nil {
+		return false, fmt.Errorf("could not resolve owner DNS record %s: %w", w.ownerName, err)
+	}
+	for _, owner := range owners {
+		if owner == w.ownerID {
+			return true, nil
+		}
+	}
+	w.logger.Info("Owner DNS record does not contain the expected owner ID", "ownerName", w.ownerName, "ownerID", w.ownerID, "owners", owners)
+	return false, nil
+}
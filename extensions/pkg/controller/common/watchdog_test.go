@@ -0,0 +1,175 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/common"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	ownerName = "owner.foo.example.com"
+	ownerID   = "foo-id"
+)
+
+type fakeResolver struct {
+	lookups  int32
+	records  []string
+	err      error
+	errUntil int32
+	onLookup func()
+}
+
+func (r *fakeResolver) LookupTXT(_ context.Context, _ string) ([]string, error) {
+	lookups := atomic.AddInt32(&r.lookups, 1)
+	if r.onLookup != nil {
+		r.onLookup()
+	}
+	if r.err != nil && (r.errUntil == 0 || lookups <= r.errUntil) {
+		return nil, r.err
+	}
+	return r.records, nil
+}
+
+func (r *fakeResolver) lookupCount() int32 {
+	return atomic.LoadInt32(&r.lookups)
+}
+
+var _ = Describe("Watchdog", func() {
+	var (
+		resolver  *fakeResolver
+		cancelled int32
+		watchdog  *Watchdog
+	)
+
+	BeforeEach(func() {
+		resolver = &fakeResolver{records: []string{ownerID}}
+		cancelled = 0
+		watchdog = NewWatchdog(resolver, ownerName, ownerID, 10*time.Millisecond, func() { atomic.AddInt32(&cancelled, 1) }, logr.Discard())
+	})
+
+	Describe("#Start", func() {
+		It("should not perform any lookup if the context is already cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			watchdog.Start(ctx)
+
+			Consistently(resolver.lookupCount, 50*time.Millisecond).Should(BeZero())
+			Expect(atomic.LoadInt32(&cancelled)).To(BeZero())
+		})
+
+		It("should keep checking as long as the owner ID matches", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Eventually(resolver.lookupCount).Should(BeNumerically(">", 1))
+			Expect(atomic.LoadInt32(&cancelled)).To(BeZero())
+		})
+
+		It("should keep checking if the owner ID is not the first TXT record", func() {
+			resolver.records = []string{"other-id", ownerID}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Eventually(resolver.lookupCount).Should(BeNumerically(">", 1))
+			Expect(atomic.LoadInt32(&cancelled)).To(BeZero())
+		})
+
+		It("should cancel dependent contexts if the owner ID does not match", func() {
+			resolver.records = []string{"other-id"}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Eventually(func() int32 { return atomic.LoadInt32(&cancelled) }).Should(Equal(int32(1)))
+			Expect(resolver.lookupCount()).To(Equal(int32(1)))
+		})
+
+		It("should retry transient resolver errors and only cancel after consecutive failures", func() {
+			resolver.err = errors.New("temporary failure in name resolution")
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Consistently(func() int32 { return atomic.LoadInt32(&cancelled) }, 15*time.Millisecond).Should(BeZero())
+			Eventually(func() int32 { return atomic.LoadInt32(&cancelled) }).Should(Equal(int32(1)))
+			Expect(resolver.lookupCount()).To(Equal(int32(3)))
+		})
+
+		It("should recover from transient resolver errors without cancelling", func() {
+			resolver.err = errors.New("temporary failure in name resolution")
+			resolver.errUntil = 2
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Eventually(resolver.lookupCount).Should(BeNumerically(">", 3))
+			Expect(atomic.LoadInt32(&cancelled)).To(BeZero())
+		})
+
+		It("should not cancel dependent contexts if its own context is cancelled during the lookup", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			resolver.err = context.Canceled
+			resolver.onLookup = cancel
+
+			watchdog.Start(ctx)
+
+			Consistently(func() int32 { return atomic.LoadInt32(&cancelled) }, 50*time.Millisecond).Should(BeZero())
+			Expect(resolver.lookupCount()).To(Equal(int32(1)))
+		})
+	})
+
+	Describe("#Healthy", func() {
+		It("should return false if the watchdog was never started", func() {
+			Expect(watchdog.Healthy(time.Minute)).To(BeFalse())
+		})
+
+		It("should return true while the poll loop is running", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watchdog.Start(ctx)
+
+			Eventually(func() bool { return watchdog.Healthy(time.Minute) }).Should(BeTrue())
+		})
+
+		It("should return false after the poll loop stops updating", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			watchdog.Start(ctx)
+
+			Eventually(func() bool { return watchdog.Healthy(time.Minute) }).Should(BeTrue())
+			cancel()
+
+			Eventually(func() bool { return watchdog.Healthy(20 * time.Millisecond) }).Should(BeFalse())
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 
 	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -33,3 +34,10 @@ type Actuator interface {
 	// Migrate the ContainerRuntime resource.
 	Migrate(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) error
 }
+
+// HealthChecker is an optional interface for Actuators that can run provider-specific health checks after a
+// successful reconciliation. The returned conditions are written to the status of the ContainerRuntime resource.
+type HealthChecker interface {
+	// HealthCheck checks the health of the given ContainerRuntime resource and returns the resulting conditions.
+	HealthCheck(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) ([]gardencorev1beta1.Condition, error)
+}
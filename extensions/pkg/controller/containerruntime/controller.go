@@ -17,6 +17,7 @@ package containerruntime
 import (
 	"time"
 
+	extensionscommon "github.com/gardener/gardener/extensions/pkg/controller/common"
 	extensionshandler "github.com/gardener/gardener/extensions/pkg/handler"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -33,6 +34,9 @@ const (
 	FinalizerName = "extensions.gardener.cloud/containerruntime"
 	// ControllerName is the name of the controller.
 	ControllerName = "containerruntime_controller"
+	// IgnoreAnnotation is the annotation that, when set to "true", marks a ContainerRuntime resource to be
+	// temporarily ignored for reconciliation.
+	IgnoreAnnotation = "containerruntime.extensions.gardener.cloud/ignore"
 )
 
 // AddArgs are arguments for adding an ContainerRuntime resources controller to a manager.
@@ -55,11 +59,14 @@ type AddArgs struct {
 	// If the annotation is not ignored, the extension controller will only reconcile
 	// with a present operation annotation typically set during a reconcile (e.g in the maintenance time) by the Gardenlet
 	IgnoreOperationAnnotation bool
+	// Drainer, if set, tracks in-flight reconciles so that the manager can wait briefly for them to reach a
+	// safe point on shutdown.
+	Drainer *extensionscommon.Drainer
 }
 
 // Add adds an ContainerRuntime controller to the given manager using the given AddArgs.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconcilerWithDrainer(args.Actuator, args.Drainer)
 	return add(mgr, args)
 }
 
@@ -67,7 +74,10 @@ func Add(mgr manager.Manager, args AddArgs) error {
 func DefaultPredicates(ignoreOperationAnnotation bool) []predicate.Predicate {
 	if ignoreOperationAnnotation {
 		return []predicate.Predicate{
-			predicate.GenerationChangedPredicate{},
+			// Filter out updates that neither change the generation nor the operation annotation (e.g.
+			// unrelated annotation bumps) to avoid needless reconciles.
+			extensionspredicate.GenerationOrOperationAnnotationChanged(),
+			extensionspredicate.ShootNotFailed(),
 		}
 	}
 	return []predicate.Predicate{
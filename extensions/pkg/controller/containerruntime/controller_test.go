@@ -0,0 +1,148 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockcache "github.com/gardener/gardener/pkg/mock/controller-runtime/cache"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Controller", func() {
+	Describe("#DefaultPredicates", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			ctrl  *gomock.Controller
+			c     *mockclient.MockClient
+			cache *mockcache.MockCache
+
+			cr *extensionsv1alpha1.ContainerRuntime
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			c = mockclient.NewMockClient(ctrl)
+			cache = mockcache.NewMockCache(ctrl)
+
+			cr = &extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should filter out events for clusters of failed shoots", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: gardencorev1beta1.ShootStatus{
+					ObservedGeneration: 1,
+					LastOperation:      &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed},
+				},
+			}
+			shootJSON, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			cluster := &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					Shoot: runtime.RawExtension{Raw: shootJSON},
+				},
+			}
+
+			cache.EXPECT().WaitForCacheSync(gomock.Any()).Return(true)
+			c.EXPECT().Get(gomock.Any(), kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, actual *extensionsv1alpha1.Cluster) error {
+					cluster.DeepCopyInto(actual)
+					return nil
+				})
+
+			predicates := containerruntime.DefaultPredicates(true)
+			for _, p := range predicates {
+				if injector, ok := p.(inject.Injector); ok {
+					Expect(injector.InjectFunc(func(i interface{}) error {
+						if _, err := inject.ClientInto(c, i); err != nil {
+							return err
+						}
+						if _, err := inject.CacheInto(cache, i); err != nil {
+							return err
+						}
+						_, err := inject.StopChannelInto(context.TODO().Done(), i)
+						return err
+					})).To(Succeed())
+				}
+			}
+
+			filtered := true
+			for _, p := range predicates {
+				if !p.Create(event.CreateEvent{Object: cr}) {
+					filtered = false
+				}
+			}
+			Expect(filtered).To(BeFalse())
+		})
+
+		It("should filter out updates that change neither the generation nor the operation annotation", func() {
+			crNew := cr.DeepCopy()
+			crNew.Labels = map[string]string{"foo": "bar"}
+
+			passed := true
+			for _, p := range containerruntime.DefaultPredicates(true) {
+				if _, ok := p.(inject.Injector); ok {
+					// The ShootNotFailed predicate needs an injected client; it is covered separately above.
+					continue
+				}
+				if !p.Update(event.UpdateEvent{ObjectOld: cr, ObjectNew: crNew}) {
+					passed = false
+				}
+			}
+			Expect(passed).To(BeFalse())
+		})
+
+		It("should not filter out updates that change the operation annotation", func() {
+			crNew := cr.DeepCopy()
+			crNew.Annotations = map[string]string{"gardener.cloud/operation": "reconcile"}
+
+			for _, p := range containerruntime.DefaultPredicates(true) {
+				if _, ok := p.(inject.Injector); ok {
+					// The ShootNotFailed predicate needs an injected client; it is covered separately above.
+					continue
+				}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: cr, ObjectNew: crNew})).To(BeTrue())
+			}
+		})
+	})
+})
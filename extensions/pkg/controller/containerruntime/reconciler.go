@@ -17,6 +17,8 @@ package containerruntime
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionscommon "github.com/gardener/gardener/extensions/pkg/controller/common"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -34,11 +37,20 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 )
 
+const (
+	// leaseExpiryRequeueThreshold is the remaining cluster lease duration below which the reconciler requeues
+	// instead of reconciling, so that ownership is re-evaluated promptly around a lease handover.
+	leaseExpiryRequeueThreshold = time.Minute
+	// ownerCheckInterval is the interval in which the owner DNS record is checked while an operation is in flight.
+	ownerCheckInterval = 30 * time.Second
+)
+
 // reconciler reconciles ContainerRuntime resources of Gardener's
 // `extensions.gardener.cloud` API group.
 type reconciler struct {
 	logger   logr.Logger
 	actuator Actuator
+	drainer  *extensionscommon.Drainer
 
 	client        client.Client
 	reader        client.Reader
@@ -48,6 +60,12 @@ type reconciler struct {
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // ContainerRuntime resources of Gardener's `extensions.gardener.cloud` API group.
 func NewReconciler(actuator Actuator) reconcile.Reconciler {
+	return NewReconcilerWithDrainer(actuator, nil)
+}
+
+// NewReconcilerWithDrainer creates a new reconcile.Reconciler like NewReconciler, additionally registering all
+// reconciles with the given drainer so that in-flight actuator operations can be drained on shutdown.
+func NewReconcilerWithDrainer(actuator Actuator, drainer *extensionscommon.Drainer) reconcile.Reconciler {
 	logger := log.Log.WithName(ControllerName)
 
 	return extensionscontroller.OperationAnnotationWrapper(
@@ -55,6 +73,7 @@ func NewReconciler(actuator Actuator) reconcile.Reconciler {
 		&reconciler{
 			logger:        logger,
 			actuator:      actuator,
+			drainer:       drainer,
 			statusUpdater: extensionscontroller.NewStatusUpdater(logger),
 		},
 	)
@@ -65,6 +84,16 @@ func (r *reconciler) InjectFunc(f inject.Func) error {
 	return f(r.actuator)
 }
 
+// InjectLogger injects the given logger into the reconciler and its status updater.
+func (r *reconciler) InjectLogger(l logr.Logger) error {
+	r.logger = l.WithName(ControllerName)
+	r.statusUpdater = extensionscontroller.NewStatusUpdater(r.logger)
+	if r.client != nil {
+		r.statusUpdater.InjectClient(r.client)
+	}
+	return nil
+}
+
 // InjectClient injects the controller runtime client into the reconciler.
 func (r *reconciler) InjectClient(client client.Client) error {
 	r.client = client
@@ -79,6 +108,17 @@ func (r *reconciler) InjectAPIReader(reader client.Reader) error {
 
 // Reconcile is the reconciler function that gets executed in case there are new events for `ContainerRuntime` resources.
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	if r.drainer != nil {
+		done := r.drainer.Begin()
+		defer done()
+
+		// Derive the context from the drainer so that in-flight actuator operations observe a shutdown as a
+		// context cancellation and can return at a safe point.
+		var cancel context.CancelFunc
+		ctx, cancel = r.drainer.WithShutdown(ctx)
+		defer cancel()
+	}
+
 	cr := &extensionsv1alpha1.ContainerRuntime{}
 	if err := r.client.Get(ctx, request.NamespacedName, cr); err != nil {
 		if errors.IsNotFound(err) {
@@ -87,32 +127,65 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	logger := r.logger.WithValues("namespace", request.Namespace, "name", request.Name)
+
+	if extensionscontroller.IsIgnored(cr, IgnoreAnnotation) {
+		logger.Info("Skipping reconciliation of ignored ContainerRuntime.")
+		return reconcile.Result{}, nil
+	}
+
 	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cr.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 	if extensionscontroller.IsFailed(cluster) {
-		r.logger.Info("Stop reconciling ContainerRuntime of failed Shoot.", "namespace", request.Namespace, "name", cr.Name)
+		logger.Info("Stop reconciling ContainerRuntime of failed Shoot.")
 		return reconcile.Result{}, nil
 	}
 
+	if remaining, ok := extensionscontroller.TimeUntilLeaseExpiry(cluster, time.Now()); ok {
+		if remaining <= 0 {
+			logger.Info("Stop reconciling ContainerRuntime as the cluster lease has expired.")
+			return reconcile.Result{}, nil
+		}
+		if remaining <= leaseExpiryRequeueThreshold {
+			logger.Info("Cluster lease is about to expire, requeueing to re-evaluate ownership.", "remaining", remaining)
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	// If the cluster records the identity of its lease holder, guard the operation with a watchdog that
+	// cancels it as soon as the owner DNS record no longer contains that identity, i.e. when a different
+	// seed has taken over the shoot.
+	if cluster.LeaseHolderIdentity != nil {
+		watchdogCtx, watchdogCancel := context.WithCancel(ctx)
+		defer watchdogCancel()
+
+		watchdog, err := extensionscommon.NewWatchdogForCluster(net.DefaultResolver, cluster, *cluster.LeaseHolderIdentity, ownerCheckInterval, watchdogCancel, logger)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not create watchdog for cluster %s: %w", cr.Namespace, err)
+		}
+		watchdog.Start(watchdogCtx)
+		ctx = watchdogCtx
+	}
+
 	operationType := gardencorev1beta1helper.ComputeOperationType(cr.ObjectMeta, cr.Status.LastOperation)
 
 	switch {
 	case extensionscontroller.IsMigrated(cr):
 		return reconcile.Result{}, nil
 	case operationType == gardencorev1beta1.LastOperationTypeMigrate:
-		return r.migrate(ctx, cr, cluster)
+		return r.migrate(ctx, logger.WithValues("operation", "migrate"), cr, cluster)
 	case cr.DeletionTimestamp != nil:
-		return r.delete(ctx, cr, cluster)
+		return r.delete(ctx, logger.WithValues("operation", "delete"), cr, cluster)
 	case cr.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationRestore:
-		return r.restore(ctx, cr, cluster)
+		return r.restore(ctx, logger.WithValues("operation", "restore"), cr, cluster)
 	default:
-		return r.reconcile(ctx, cr, cluster, operationType)
+		return r.reconcile(ctx, logger.WithValues("operation", "reconcile"), cr, cluster, operationType)
 	}
 }
 
-func (r *reconciler) reconcile(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster, operationType gardencorev1beta1.LastOperationType) (reconcile.Result, error) {
+func (r *reconciler) reconcile(ctx context.Context, logger logr.Logger, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster, operationType gardencorev1beta1.LastOperationType) (reconcile.Result, error) {
 	if err := controllerutils.EnsureFinalizer(ctx, r.reader, r.client, cr, FinalizerName); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -130,10 +203,24 @@ func (r *reconciler) reconcile(ctx context.Context, cr *extensionsv1alpha1.Conta
 		return reconcile.Result{}, err
 	}
 
+	if healthChecker, ok := r.actuator.(HealthChecker); ok {
+		conditions, err := healthChecker.HealthCheck(ctx, cr, cluster)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error health checking container runtime: %+v", err)
+		}
+
+		if err := extensionscontroller.TryUpdateStatus(ctx, extensionscontroller.StatusUpdateBackoff, r.client, cr, func() error {
+			cr.GetExtensionStatus().SetConditions(conditions)
+			return nil
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	return reconcile.Result{}, nil
 }
 
-func (r *reconciler) restore(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+func (r *reconciler) restore(ctx context.Context, logger logr.Logger, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	if err := controllerutils.EnsureFinalizer(ctx, r.reader, r.client, cr, FinalizerName); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -158,9 +245,9 @@ func (r *reconciler) restore(ctx context.Context, cr *extensionsv1alpha1.Contain
 	return reconcile.Result{}, nil
 }
 
-func (r *reconciler) delete(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+func (r *reconciler) delete(ctx context.Context, logger logr.Logger, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	if !controllerutil.ContainsFinalizer(cr, FinalizerName) {
-		r.logger.Info("Deleting container runtime causes a no-op as there is no finalizer.", "containerruntime", cr.Name)
+		logger.Info("Deleting container runtime causes a no-op as there is no finalizer.")
 		return reconcile.Result{}, nil
 	}
 
@@ -177,7 +264,7 @@ func (r *reconciler) delete(ctx context.Context, cr *extensionsv1alpha1.Containe
 		return reconcile.Result{}, err
 	}
 
-	r.logger.Info("Removing finalizer.", "containerruntime", cr.Name)
+	logger.Info("Removing finalizer.")
 	if err := controllerutils.RemoveFinalizer(ctx, r.reader, r.client, cr, FinalizerName); err != nil {
 		return reconcile.Result{}, fmt.Errorf("error removing finalizer from the container runtime resource: %+v", err)
 	}
@@ -185,7 +272,7 @@ func (r *reconciler) delete(ctx context.Context, cr *extensionsv1alpha1.Containe
 	return reconcile.Result{}, nil
 }
 
-func (r *reconciler) migrate(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+func (r *reconciler) migrate(ctx context.Context, logger logr.Logger, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	if err := r.statusUpdater.Processing(ctx, cr, gardencorev1beta1.LastOperationTypeMigrate, "Migrating the container runtime"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -199,7 +286,7 @@ func (r *reconciler) migrate(ctx context.Context, cr *extensionsv1alpha1.Contain
 		return reconcile.Result{}, err
 	}
 
-	r.logger.Info("Removing all finalizers.", "containerruntime", cr.Name)
+	logger.Info("Removing all finalizers.")
 	if err := extensionscontroller.DeleteAllFinalizers(ctx, r.client, cr); err != nil {
 		return reconcile.Result{}, fmt.Errorf("error removing finalizers from the container runtime resource: %+v", err)
 	}
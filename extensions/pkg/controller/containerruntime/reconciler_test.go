@@ -0,0 +1,465 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionscommon "github.com/gardener/gardener/extensions/pkg/controller/common"
+	"github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+const (
+	name      = "foo"
+	namespace = "shoot--foo--bar"
+)
+
+type logEntry struct {
+	msg           string
+	keysAndValues []interface{}
+}
+
+type logRecorder struct {
+	mutex   sync.Mutex
+	entries []logEntry
+}
+
+func (r *logRecorder) record(msg string, keysAndValues []interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.entries = append(r.entries, logEntry{msg: msg, keysAndValues: keysAndValues})
+}
+
+// recordingLogger is a logr.Logger that records all log entries with their key/value pairs.
+type recordingLogger struct {
+	recorder *logRecorder
+	values   []interface{}
+}
+
+func (l *recordingLogger) Enabled() bool { return true }
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.recorder.record(msg, append(l.values[:len(l.values):len(l.values)], keysAndValues...))
+}
+
+func (l *recordingLogger) Error(_ error, msg string, keysAndValues ...interface{}) {
+	l.recorder.record(msg, append(l.values[:len(l.values):len(l.values)], keysAndValues...))
+}
+
+func (l *recordingLogger) V(_ int) logr.Logger { return l }
+
+func (l *recordingLogger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	return &recordingLogger{recorder: l.recorder, values: append(l.values[:len(l.values):len(l.values)], keysAndValues...)}
+}
+
+func (l *recordingLogger) WithName(_ string) logr.Logger { return l }
+
+type fakeActuator struct{}
+
+func (a *fakeActuator) Reconcile(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) error {
+	return nil
+}
+func (a *fakeActuator) Delete(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) error {
+	return nil
+}
+func (a *fakeActuator) Restore(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) error {
+	return nil
+}
+func (a *fakeActuator) Migrate(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) error {
+	return nil
+}
+
+// blockingActuator is a fakeActuator whose Reconcile blocks until its context is cancelled.
+type blockingActuator struct {
+	fakeActuator
+	started chan struct{}
+}
+
+func (a *blockingActuator) Reconcile(ctx context.Context, _ *extensionsv1alpha1.ContainerRuntime, _ *extensioncontroller.Cluster) error {
+	close(a.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// observingActuator is a fakeActuator that records the status observed generation as seen while the
+// restore operation is processing.
+type observingActuator struct {
+	fakeActuator
+	observedGeneration int64
+}
+
+func (a *observingActuator) Restore(_ context.Context, cr *extensionsv1alpha1.ContainerRuntime, _ *extensioncontroller.Cluster) error {
+	a.observedGeneration = cr.Status.ObservedGeneration
+	return nil
+}
+
+// healthCheckActuator is a fakeActuator that additionally implements the optional HealthChecker interface.
+type healthCheckActuator struct {
+	fakeActuator
+	conditions []gardencorev1beta1.Condition
+	err        error
+}
+
+func (a *healthCheckActuator) HealthCheck(context.Context, *extensionsv1alpha1.ContainerRuntime, *extensioncontroller.Cluster) ([]gardencorev1beta1.Condition, error) {
+	return a.conditions, a.err
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx     = context.TODO()
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: name}}
+
+		recorder        *logRecorder
+		fakeClient      client.Client
+		reconciler      reconcile.Reconciler
+		leaseExpiration *metav1.Time
+		actuator        containerruntime.Actuator
+		drainer         *extensionscommon.Drainer
+
+		newReconciler = func(objs ...client.Object) {
+			s := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+
+			runtimeObjs := []runtime.Object{&extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec:       extensionsv1alpha1.ClusterSpec{LeaseExpiration: leaseExpiration},
+			}}
+			for _, obj := range objs {
+				runtimeObjs = append(runtimeObjs, obj)
+			}
+			fakeClient = fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(runtimeObjs...).Build()
+
+			recorder = &logRecorder{}
+			reconciler = containerruntime.NewReconcilerWithDrainer(actuator, drainer)
+
+			injectInto := func(i interface{}) error {
+				if _, err := inject.ClientInto(fakeClient, i); err != nil {
+					return err
+				}
+				if _, err := inject.APIReaderInto(fakeClient, i); err != nil {
+					return err
+				}
+				if _, err := inject.LoggerInto(&recordingLogger{recorder: recorder}, i); err != nil {
+					return err
+				}
+				return nil
+			}
+			Expect(injectInto(reconciler)).To(Succeed())
+			_, err := inject.InjectorInto(injectInto, reconciler)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		keysAndValuesMap = func(entry logEntry) map[string]interface{} {
+			result := map[string]interface{}{}
+			for i := 0; i+1 < len(entry.keysAndValues); i += 2 {
+				result[entry.keysAndValues[i].(string)] = entry.keysAndValues[i+1]
+			}
+			return result
+		}
+	)
+
+	BeforeEach(func() {
+		leaseExpiration = nil
+		actuator = &fakeActuator{}
+		drainer = nil
+	})
+
+	Context("cluster lease", func() {
+		var cr *extensionsv1alpha1.ContainerRuntime
+
+		BeforeEach(func() {
+			cr = &extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+		})
+
+		It("should reconcile normally if the lease expiry is far in the future", func() {
+			leaseExpiration = &metav1.Time{Time: time.Now().Add(time.Hour)}
+			newReconciler(cr)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).NotTo(BeNil())
+			Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should requeue without reconciling if the lease is about to expire", func() {
+			leaseExpiration = &metav1.Time{Time: time.Now().Add(10 * time.Second)}
+			newReconciler(cr)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(result.RequeueAfter).To(BeNumerically("<=", 10*time.Second))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).To(BeNil())
+		})
+
+		It("should stop reconciling if the lease has expired", func() {
+			leaseExpiration = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			newReconciler(cr)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).To(BeNil())
+		})
+	})
+
+	Context("health check", func() {
+		var cr *extensionsv1alpha1.ContainerRuntime
+
+		BeforeEach(func() {
+			cr = &extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+		})
+
+		It("should set a passing condition returned by the actuator", func() {
+			actuator = &healthCheckActuator{conditions: []gardencorev1beta1.Condition{{
+				Type:   "ContainerRuntimeHealthy",
+				Status: gardencorev1beta1.ConditionTrue,
+			}}}
+			newReconciler(cr)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(cr.Status.Conditions).To(HaveLen(1))
+			Expect(cr.Status.Conditions[0].Type).To(Equal(gardencorev1beta1.ConditionType("ContainerRuntimeHealthy")))
+			Expect(cr.Status.Conditions[0].Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+
+		It("should set a failing condition returned by the actuator", func() {
+			actuator = &healthCheckActuator{conditions: []gardencorev1beta1.Condition{{
+				Type:   "ContainerRuntimeHealthy",
+				Status: gardencorev1beta1.ConditionFalse,
+			}}}
+			newReconciler(cr)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(cr.Status.Conditions).To(HaveLen(1))
+			Expect(cr.Status.Conditions[0].Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		})
+
+		It("should return an error if the health check fails", func() {
+			actuator = &healthCheckActuator{err: errors.New("health check failed")}
+			newReconciler(cr)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("health check failed")))
+		})
+	})
+
+	Context("restore", func() {
+		It("should track the generation in the observed generation while the restore is processing", func() {
+			observing := &observingActuator{}
+			actuator = observing
+			newReconciler(&extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   namespace,
+					Generation:  2,
+					Annotations: map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationRestore},
+				},
+			})
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(observing.observedGeneration).To(Equal(int64(2)))
+
+			cr := &extensionsv1alpha1.ContainerRuntime{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.ObservedGeneration).To(Equal(int64(2)))
+			Expect(cr.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
+			Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+	})
+
+	Context("ignore annotations", func() {
+		var cr *extensionsv1alpha1.ContainerRuntime
+
+		BeforeEach(func() {
+			cr = &extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+		})
+
+		It("should skip reconciliation if the operation annotation is set to ignore", func() {
+			cr.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationIgnore}
+			newReconciler(cr)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).To(BeNil())
+		})
+
+		It("should skip reconciliation if the ignore annotation is set to true", func() {
+			cr.Annotations = map[string]string{containerruntime.IgnoreAnnotation: "true"}
+			newReconciler(cr)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).To(BeNil())
+		})
+
+		It("should reconcile normally if no ignore annotation is set", func() {
+			newReconciler(cr)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+			Expect(cr.Status.LastOperation).NotTo(BeNil())
+			Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+	})
+
+	Context("graceful shutdown", func() {
+		It("should cancel an in-flight reconcile and drain it on shutdown", func() {
+			drainer = extensionscommon.NewDrainer(logr.Discard())
+			blocking := &blockingActuator{started: make(chan struct{})}
+			actuator = blocking
+			newReconciler(&extensionsv1alpha1.ContainerRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			})
+
+			result := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				_, err := reconciler.Reconcile(ctx, request)
+				result <- err
+			}()
+
+			// Wait until the actuator operation is in flight, then initiate the shutdown.
+			Eventually(blocking.started).Should(BeClosed())
+			Expect(drainer.Drain(5 * time.Second)).To(BeTrue())
+
+			Eventually(result).Should(Receive(MatchError(context.Canceled)))
+		})
+	})
+
+	It("should log with consistent namespace, name, and operation fields during reconciliation", func() {
+		newReconciler(&extensionsv1alpha1.ContainerRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		})
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.entries).NotTo(BeEmpty())
+		for _, entry := range recorder.entries {
+			fields := keysAndValuesMap(entry)
+			Expect(fields).To(HaveKeyWithValue("namespace", namespace), entry.msg)
+			Expect(fields).To(HaveKeyWithValue("name", name), entry.msg)
+			Expect(fields).To(HaveKey("operation"), entry.msg)
+		}
+	})
+
+	It("should clear a stale last error after a successful reconcile", func() {
+		newReconciler(&extensionsv1alpha1.ContainerRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Status: extensionsv1alpha1.ContainerRuntimeStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastError: &gardencorev1beta1.LastError{
+						Description: "something failed",
+					},
+				},
+			},
+		})
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		cr := &extensionsv1alpha1.ContainerRuntime{}
+		Expect(fakeClient.Get(ctx, request.NamespacedName, cr)).To(Succeed())
+		Expect(cr.Status.LastError).To(BeNil())
+		Expect(cr.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+	})
+
+	It("should log the delete operation field when deleting without a finalizer", func() {
+		now := metav1.Now()
+		newReconciler(&extensionsv1alpha1.ContainerRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				DeletionTimestamp: &now,
+			},
+		})
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.entries).To(HaveLen(1))
+		fields := keysAndValuesMap(recorder.entries[0])
+		Expect(fields).To(HaveKeyWithValue("namespace", namespace))
+		Expect(fields).To(HaveKeyWithValue("name", name))
+		Expect(fields).To(HaveKeyWithValue("operation", "delete"))
+	})
+})
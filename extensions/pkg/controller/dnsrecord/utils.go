@@ -15,15 +15,60 @@
 package dnsrecord
 
 import (
+	"fmt"
 	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 const (
 	// metaRecordPrefix is the prefix of meta DNS records that may exist if the shoot was previously reconciled
 	// with the dns-external controller.
 	metaRecordPrefix = "comment-"
+
+	// OptionsAnnotationPrefix is the prefix of DNSRecord annotations that carry additional provider options,
+	// e.g. an alias target or a health check. Annotations with this prefix are read by GetDNSRecordOptions.
+	OptionsAnnotationPrefix = "dnsrecord.extensions.gardener.cloud/"
+
+	// aliasTargetAnnotationSuffix is the annotation key suffix for specifying an alias target for the record.
+	aliasTargetAnnotationSuffix = "alias-target"
+	// healthCheckIDAnnotationSuffix is the annotation key suffix for associating the record with a provider
+	// health check.
+	healthCheckIDAnnotationSuffix = "health-check-id"
 )
 
+// DNSRecordOptions contains additional, provider-independent options for a DNSRecord object that are passed
+// via annotations with the OptionsAnnotationPrefix prefix.
+type DNSRecordOptions struct {
+	// AliasTarget is the target the record should be an alias of, if supported by the provider.
+	AliasTarget *string
+	// HealthCheckID is the ID of a provider health check to associate with the record.
+	HealthCheckID *string
+}
+
+// GetDNSRecordOptions reads all annotations of the given DNSRecord object with the OptionsAnnotationPrefix
+// prefix into a DNSRecordOptions struct. It returns an error if an annotation with this prefix has an
+// unrecognized key, so that typos do not get silently ignored. Annotations without the prefix are not
+// considered options and are left alone.
+func GetDNSRecordOptions(dns *extensionsv1alpha1.DNSRecord) (*DNSRecordOptions, error) {
+	options := &DNSRecordOptions{}
+	for key, value := range dns.Annotations {
+		if !strings.HasPrefix(key, OptionsAnnotationPrefix) {
+			continue
+		}
+		value := value
+		switch strings.TrimPrefix(key, OptionsAnnotationPrefix) {
+		case aliasTargetAnnotationSuffix:
+			options.AliasTarget = &value
+		case healthCheckIDAnnotationSuffix:
+			options.HealthCheckID = &value
+		default:
+			return nil, fmt.Errorf("unrecognized DNSRecord option annotation %q", key)
+		}
+	}
+	return options, nil
+}
+
 // MatchesDomain returns true if the given name matches (is a subdomain) of the given domain, false otherwise.
 func MatchesDomain(name, domain string) bool {
 	return strings.HasSuffix(name, "."+domain) || domain == name
@@ -15,11 +15,14 @@
 package dnsrecord_test
 
 import (
-	. "github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 var _ = Describe("Utils", func() {
@@ -62,4 +65,46 @@ var _ = Describe("Utils", func() {
 		Entry("wildcard name", "*.test.example.com", "*.comment-test.example.com"),
 		Entry("empty name", "", "comment-"),
 	)
+
+	Describe("#GetDNSRecordOptions", func() {
+		var dns *extensionsv1alpha1.DNSRecord
+
+		BeforeEach(func() {
+			dns = &extensionsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+			}
+		})
+
+		It("should return empty options for an object without annotations", func() {
+			options, err := GetDNSRecordOptions(dns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options).To(Equal(&DNSRecordOptions{}))
+		})
+
+		It("should read recognized option annotations", func() {
+			dns.Annotations = map[string]string{
+				OptionsAnnotationPrefix + "alias-target":    "lb.example.com",
+				OptionsAnnotationPrefix + "health-check-id": "hc-1234",
+				"some-other-annotation":                     "untouched",
+			}
+
+			options, err := GetDNSRecordOptions(dns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options).To(Equal(&DNSRecordOptions{
+				AliasTarget:   pointer.StringPtr("lb.example.com"),
+				HealthCheckID: pointer.StringPtr("hc-1234"),
+			}))
+		})
+
+		It("should fail for an unrecognized option annotation", func() {
+			dns.Annotations = map[string]string{
+				OptionsAnnotationPrefix + "alias-tagret": "lb.example.com",
+			}
+
+			_, err := GetDNSRecordOptions(dns)
+			Expect(err).To(MatchError(ContainSubstring("unrecognized DNSRecord option annotation")))
+		})
+	})
 })
@@ -16,6 +16,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 
@@ -74,3 +75,25 @@ func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, request reco
 	}
 	return o.Reconciler.Reconcile(ctx, request)
 }
+
+// VerifyOperationAnnotationWrapper reconciles the given object with the given wrapped reconciler and verifies that
+// the reconcile operation annotation is removed before the request is delegated. It allows extension authors to
+// test that their reconciler is properly integrated with OperationAnnotationWrapper.
+func VerifyOperationAnnotationWrapper(ctx context.Context, reconciler reconcile.Reconciler, c client.Client, obj client.Object) error {
+	request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+	if _, err := reconciler.Reconcile(ctx, request); err != nil {
+		return err
+	}
+
+	// Read the object into a fresh copy since the given object may still carry the annotation
+	fresh := obj.DeepCopyObject().(client.Object)
+	fresh.SetAnnotations(nil)
+	if err := c.Get(ctx, request.NamespacedName, fresh); err != nil {
+		return err
+	}
+
+	if fresh.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
+		return fmt.Errorf("reconcile operation annotation was not removed before delegating to the inner reconciler")
+	}
+	return nil
+}
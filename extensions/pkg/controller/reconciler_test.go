@@ -0,0 +1,86 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Reconciler", func() {
+	Describe("#VerifyOperationAnnotationWrapper", func() {
+		var (
+			ctx = context.TODO()
+
+			infra      *extensionsv1alpha1.Infrastructure
+			fakeClient client.Client
+
+			innerInvoked bool
+			inner        reconcile.Reconciler
+			wrapped      reconcile.Reconciler
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+					Annotations: map[string]string{
+						v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile,
+					},
+				},
+			}
+
+			s := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			fakeClient = fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(infra).Build()
+
+			innerInvoked = false
+			inner = reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				innerInvoked = true
+				return reconcile.Result{}, nil
+			})
+
+			wrapped = controller.OperationAnnotationWrapper(
+				func() client.Object { return &extensionsv1alpha1.Infrastructure{} },
+				inner,
+			)
+			_, err := inject.ClientInto(fakeClient, wrapped)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should succeed because the wrapper consumes the operation annotation", func() {
+			Expect(controller.VerifyOperationAnnotationWrapper(ctx, wrapped, fakeClient, infra)).To(Succeed())
+			Expect(innerInvoked).To(BeTrue())
+		})
+
+		It("should fail for a reconciler that does not consume the operation annotation", func() {
+			Expect(controller.VerifyOperationAnnotationWrapper(ctx, inner, fakeClient, infra)).
+				To(MatchError(ContainSubstring("operation annotation was not removed")))
+		})
+	})
+})
@@ -15,10 +15,20 @@
 package controller
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+// OwnerDNSNamePrefix is the part of a FQDN which will be used to construct the domain name of the DNS TXT record
+// containing the ID of the seed currently owning a Shoot cluster. For example, when a Shoot specifies domain
+// 'cluster.example.com', the owner record domain would be 'owner.cluster.example.com'.
+const OwnerDNSNamePrefix = "owner"
+
 // ChartRendererFactory creates chartrenderer.Interface to be used by this actuator.
 type ChartRendererFactory interface {
 	// NewChartRendererForShoot creates a new chartrenderer.Interface for the shoot cluster.
@@ -74,6 +84,35 @@ func IsUnmanagedDNSProvider(cluster *Cluster) bool {
 	return dns == nil || (dns.Domain == nil && len(dns.Providers) > 0 && dns.Providers[0].Type != nil && *dns.Providers[0].Type == "unmanaged")
 }
 
+// OwnerDNSName returns the domain name of the DNS TXT record containing the ID of the seed currently owning
+// the given shoot cluster. It is derived from the shoot's external DNS domain if set, falling back to the internal
+// domain taken from the shoot's 'internal' advertised address. An error is returned if neither is available.
+func OwnerDNSName(cluster *Cluster) (string, error) {
+	if cluster == nil || cluster.Shoot == nil {
+		return "", fmt.Errorf("cluster does not contain a shoot")
+	}
+
+	if dns := cluster.Shoot.Spec.DNS; dns != nil && dns.Domain != nil && len(*dns.Domain) > 0 {
+		return OwnerDNSNamePrefix + "." + *dns.Domain, nil
+	}
+
+	for _, address := range cluster.Shoot.Status.AdvertisedAddresses {
+		if address.Name != "internal" {
+			continue
+		}
+		u, err := url.Parse(address.URL)
+		if err != nil {
+			return "", fmt.Errorf("could not parse internal advertised address %q: %w", address.URL, err)
+		}
+		domain := strings.TrimPrefix(u.Hostname(), gutil.APIServerFQDNPrefix+".")
+		if len(domain) > 0 {
+			return OwnerDNSNamePrefix + "." + domain, nil
+		}
+	}
+
+	return "", fmt.Errorf("shoot %q specifies neither an external DNS domain nor an internal advertised address", cluster.Shoot.Name)
+}
+
 // GetReplicas returns the woken up replicas of the given Shoot.
 func GetReplicas(cluster *Cluster, wokenUp int) int {
 	if IsHibernated(cluster) {
@@ -100,6 +100,50 @@ var _ = Describe("Shoot", func() {
 		}, true),
 	)
 
+	Describe("#OwnerDNSName", func() {
+		It("should derive the owner DNS name from the external domain", func() {
+			cluster := &Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						DNS: &gardencorev1beta1.DNS{
+							Domain: &dnsDomain,
+						},
+					},
+				},
+			}
+
+			name, err := OwnerDNSName(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("owner." + dnsDomain))
+		})
+
+		It("should fall back to the internal advertised address", func() {
+			cluster := &Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Status: gardencorev1beta1.ShootStatus{
+						AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+							{Name: "external", URL: "https://1.2.3.4"},
+							{Name: "internal", URL: "https://api.internal.example.com"},
+						},
+					},
+				},
+			}
+
+			name, err := OwnerDNSName(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("owner.internal.example.com"))
+		})
+
+		It("should return an error if neither domain is available", func() {
+			cluster := &Cluster{
+				Shoot: &gardencorev1beta1.Shoot{},
+			}
+
+			_, err := OwnerDNSName(cluster)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	DescribeTable("#GetReplicas",
 		func(hibernation *gardencorev1beta1.Hibernation, wokenUp, expectation int) {
 			cluster := &Cluster{
@@ -24,10 +24,21 @@ import (
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// StatusUpdateBackoff is the backoff used by the status updater for retrying status updates on conflict. Compared
+// to retry.DefaultBackoff it uses a larger jitter fraction so that many controllers conflicting at the same time
+// (e.g. after a gardenlet rollout) don't synchronize their retries.
+var StatusUpdateBackoff = wait.Backoff{
+	Steps:    retry.DefaultBackoff.Steps,
+	Duration: retry.DefaultBackoff.Duration,
+	Factor:   retry.DefaultBackoff.Factor,
+	Jitter:   0.5,
+}
+
 // LastOperation creates a new LastOperation from the given parameters.
 func LastOperation(t gardencorev1beta1.LastOperationType, state gardencorev1beta1.LastOperationState, progress int32, description string) *gardencorev1beta1.LastOperation {
 	return &gardencorev1beta1.LastOperation{
@@ -93,11 +104,12 @@ func (s *statusUpdater) Processing(ctx context.Context, obj extensionsv1alpha1.O
 		return fmt.Errorf("client is not set. Call InjectClient() first")
 	}
 
-	s.logger.Info(description, s.logKeysAndValues(obj)...)
+	s.logger.Info(description, s.logKeysAndValues(obj, lastOperationType)...)
 
-	return TryUpdateStatus(ctx, retry.DefaultBackoff, s.client, obj, func() error {
+	return TryUpdateStatus(ctx, StatusUpdateBackoff, s.client, obj, func() error {
 		lastOp := LastOperation(lastOperationType, gardencorev1beta1.LastOperationStateProcessing, 1, description)
 
+		obj.GetExtensionStatus().SetObservedGeneration(obj.GetGeneration())
 		obj.GetExtensionStatus().SetLastOperation(lastOp)
 		return nil
 	})
@@ -109,9 +121,9 @@ func (s *statusUpdater) Error(ctx context.Context, obj extensionsv1alpha1.Object
 	}
 
 	errDescription := gardencorev1beta1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err))
-	s.logger.Error(fmt.Errorf(errDescription), "error", s.logKeysAndValues(obj)...)
+	s.logger.Error(fmt.Errorf(errDescription), "error", s.logKeysAndValues(obj, lastOperationType)...)
 
-	return TryUpdateStatus(ctx, retry.DefaultBackoff, s.client, obj, func() error {
+	return TryUpdateStatus(ctx, StatusUpdateBackoff, s.client, obj, func() error {
 		lastOp, lastErr := ReconcileError(lastOperationType, errDescription, 50, gardencorev1beta1helper.ExtractErrorCodes(gardencorev1beta1helper.DetermineError(err, err.Error()))...)
 
 		obj.GetExtensionStatus().SetObservedGeneration(obj.GetGeneration())
@@ -126,9 +138,9 @@ func (s *statusUpdater) Success(ctx context.Context, obj extensionsv1alpha1.Obje
 		return fmt.Errorf("client is not set. Call InjectClient() first")
 	}
 
-	s.logger.Info(description, s.logKeysAndValues(obj)...)
+	s.logger.Info(description, s.logKeysAndValues(obj, lastOperationType)...)
 
-	return TryUpdateStatus(ctx, retry.DefaultBackoff, s.client, obj, func() error {
+	return TryUpdateStatus(ctx, StatusUpdateBackoff, s.client, obj, func() error {
 		lastOp, lastErr := ReconcileSucceeded(lastOperationType, description)
 
 		obj.GetExtensionStatus().SetObservedGeneration(obj.GetGeneration())
@@ -138,11 +150,11 @@ func (s *statusUpdater) Success(ctx context.Context, obj extensionsv1alpha1.Obje
 	})
 }
 
-func (s *statusUpdater) logKeysAndValues(obj metav1.Object) []interface{} {
+func (s *statusUpdater) logKeysAndValues(obj metav1.Object, lastOperationType gardencorev1beta1.LastOperationType) []interface{} {
 	var keysAndValues []interface{}
 	if ns := obj.GetNamespace(); ns != "" {
 		keysAndValues = append(keysAndValues, "namespace", ns)
 	}
-	keysAndValues = append(keysAndValues, "name", obj.GetName())
+	keysAndValues = append(keysAndValues, "name", obj.GetName(), "operation", lastOperationType)
 	return keysAndValues
 }
@@ -29,7 +29,9 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
@@ -95,7 +97,12 @@ var _ = Describe("Status", func() {
 				c.EXPECT().Status().Return(c),
 				c.EXPECT().Get(ctx, kutil.Key(obj.GetNamespace(), obj.GetName()), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})),
 				c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).Do(func(ctx context.Context, obj extensionsv1alpha1.Object, opts ...client.UpdateOption) {
-					lastOperation := obj.GetExtensionStatus().GetLastOperation()
+					var (
+						lastOperation      = obj.GetExtensionStatus().GetLastOperation()
+						observedGeneration = obj.GetExtensionStatus().GetObservedGeneration()
+					)
+
+					Expect(observedGeneration).To(Equal(generation))
 
 					Expect(lastOperation.Type).To(Equal(lastOpType))
 					Expect(lastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateProcessing))
@@ -106,6 +113,27 @@ var _ = Describe("Status", func() {
 
 			Expect(statusUpdater.Processing(ctx, obj, lastOpType, lastOpDesc)).To(Succeed())
 		})
+
+		It("should retry on conflict and eventually succeed", func() {
+			conflictErr := apierrors.NewConflict(schema.GroupResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Resource: "infrastructures"}, obj.GetName(), fakeErr)
+
+			gomock.InOrder(
+				c.EXPECT().Status().Return(c),
+				c.EXPECT().Get(ctx, kutil.Key(obj.GetNamespace(), obj.GetName()), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})),
+				c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).Return(conflictErr),
+				c.EXPECT().Get(ctx, kutil.Key(obj.GetNamespace(), obj.GetName()), gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})),
+				c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})),
+			)
+
+			Expect(statusUpdater.Processing(ctx, obj, lastOpType, lastOpDesc)).To(Succeed())
+		})
+	})
+
+	Describe("StatusUpdateBackoff", func() {
+		It("should include jitter to spread retries across controllers", func() {
+			Expect(StatusUpdateBackoff.Jitter).To(BeNumerically(">", 0))
+			Expect(StatusUpdateBackoff.Steps).To(BeNumerically(">", 1))
+		})
 	})
 
 	Describe("#Error", func() {
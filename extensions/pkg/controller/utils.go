@@ -20,6 +20,7 @@ import (
 	"reflect"
 
 	controllererror "github.com/gardener/gardener/extensions/pkg/controller/error"
+	"github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -27,6 +28,7 @@ import (
 
 	resourcemanagerv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -201,6 +203,41 @@ func IsMigrated(obj extensionsv1alpha1.Object) bool {
 		lastOp.State == gardencorev1beta1.LastOperationStateSucceeded
 }
 
+// IsIgnored checks if the given extension object is marked to be temporarily ignored for reconciliation,
+// either via the generic operation annotation with the value "ignore" or via the given kind-specific
+// ignore annotation with the value "true".
+func IsIgnored(obj client.Object, ignoreAnnotation string) bool {
+	annotations := obj.GetAnnotations()
+	return annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationIgnore ||
+		annotations[ignoreAnnotation] == "true"
+}
+
+// ListByType lists all objects of the given list's kind in the given namespace and filters out all items whose
+// `spec.type` does not match the given extension type. The filtered items are written back into the given list.
+func ListByType(ctx context.Context, c client.Client, list client.ObjectList, namespace, extensionType string) error {
+	if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	var filtered []runtime.Object
+	for _, item := range items {
+		acc, err := extensions.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if acc.GetExtensionSpec().GetExtensionType() == extensionType {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return meta.SetList(list, filtered)
+}
+
 // GetObjectByReference gets an object by the given reference, in the given namespace.
 // If the object kind doesn't match the given reference kind this will result in an error.
 func GetObjectByReference(ctx context.Context, c client.Client, ref *autoscalingv1.CrossVersionObjectReference, namespace string, obj client.Object) error {
@@ -31,9 +31,11 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Utils", func() {
@@ -253,4 +255,43 @@ var _ = Describe("Utils", func() {
 			Expect(secret).To(Equal(refSecret))
 		})
 	})
+
+	Describe("#ListByType", func() {
+		var (
+			ctx       = context.TODO()
+			namespace = "shoot--test--foo"
+
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fooExtension := &extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+				Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"}},
+			}
+			barExtension := &extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+				Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "bar"}},
+			}
+
+			s := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			fakeClient = fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(fooExtension, barExtension).Build()
+		})
+
+		It("should only return objects of the requested type", func() {
+			list := &extensionsv1alpha1.ExtensionList{}
+			Expect(controller.ListByType(ctx, fakeClient, list, namespace, "foo")).To(Succeed())
+
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Name).To(Equal("foo"))
+		})
+
+		It("should return an empty list if no objects of the requested type exist", func() {
+			list := &extensionsv1alpha1.ExtensionList{}
+			Expect(controller.ListByType(ctx, fakeClient, list, namespace, "baz")).To(Succeed())
+
+			Expect(list.Items).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,61 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "cluster_lease_watchdog_controller"
+
+// AddArgs are arguments for adding a cluster lease watchdog controller to a manager.
+type AddArgs struct {
+	// ControllerOptions are the controller options used for creating a controller.
+	// The options.Reconciler is always overridden with the watchdog reconciler.
+	ControllerOptions controller.Options
+	// Predicates are the predicates to use. If unset, only changes of the Cluster's lease expiration
+	// trigger a reconciliation.
+	Predicates []predicate.Predicate
+}
+
+// Add creates a new cluster lease watchdog controller and adds it to the given manager. It returns the
+// watchdog so that extension controllers can register cancel funcs for their clusters.
+func Add(mgr manager.Manager, args AddArgs) (Watchdog, error) {
+	watchdog := NewWatchdog()
+	args.ControllerOptions.Reconciler = watchdog
+
+	ctrl, err := controller.New(ControllerName, mgr, args.ControllerOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates := args.Predicates
+	if len(predicates) == 0 {
+		predicates = []predicate.Predicate{LeaseExpirationChanged()}
+	}
+
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &handler.EnqueueRequestForObject{}, predicates...); err != nil {
+		return nil, err
+	}
+
+	return watchdog, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// LeaseExpirationChanged is a predicate that reacts only to changes of the Cluster's lease expiration,
+// so that unrelated Cluster updates do not trigger the watchdog. Delete events always pass so that the
+// watchdog can deregister deleted clusters.
+func LeaseExpirationChanged() predicate.Predicate {
+	leaseExpiration := func(obj client.Object) *metav1.Time {
+		cluster, ok := obj.(*extensionsv1alpha1.Cluster)
+		if !ok {
+			return nil
+		}
+		return cluster.Spec.LeaseExpiration
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			return leaseExpiration(event.Object) != nil
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			return !apiequality.Semantic.DeepEqual(leaseExpiration(event.ObjectOld), leaseExpiration(event.ObjectNew))
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return leaseExpiration(event.Object) != nil
+		},
+		DeleteFunc: func(event event.DeleteEvent) bool {
+			return true
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/watchdog"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Predicate", func() {
+	Describe("#LeaseExpirationChanged", func() {
+		var (
+			predicate = LeaseExpirationChanged()
+
+			cluster *extensionsv1alpha1.Cluster
+		)
+
+		BeforeEach(func() {
+			cluster = &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "shoot--foo--bar",
+				},
+			}
+		})
+
+		It("should filter an update that does not change the lease expiration", func() {
+			leaseExpiration := metav1.Now()
+			cluster.Spec.LeaseExpiration = &leaseExpiration
+
+			newCluster := cluster.DeepCopy()
+			newCluster.Annotations = map[string]string{"foo": "bar"}
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: newCluster})).To(BeFalse())
+		})
+
+		It("should pass an update that changes the lease expiration", func() {
+			leaseExpiration := metav1.Now()
+			cluster.Spec.LeaseExpiration = &leaseExpiration
+
+			newCluster := cluster.DeepCopy()
+			newLeaseExpiration := metav1.NewTime(leaseExpiration.Add(time.Minute))
+			newCluster.Spec.LeaseExpiration = &newLeaseExpiration
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: newCluster})).To(BeTrue())
+		})
+
+		It("should pass an update that sets the lease expiration", func() {
+			newCluster := cluster.DeepCopy()
+			leaseExpiration := metav1.Now()
+			newCluster.Spec.LeaseExpiration = &leaseExpiration
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: newCluster})).To(BeTrue())
+		})
+
+		It("should filter a create event without lease expiration", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: cluster})).To(BeFalse())
+		})
+
+		It("should pass a create event with lease expiration", func() {
+			leaseExpiration := metav1.Now()
+			cluster.Spec.LeaseExpiration = &leaseExpiration
+
+			Expect(predicate.Create(event.CreateEvent{Object: cluster})).To(BeTrue())
+		})
+
+		It("should pass a delete event", func() {
+			Expect(predicate.Delete(event.DeleteEvent{Object: cluster})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Watchdog watches the lease expiration of Cluster resources and calls a registered cancel func when
+// a cluster's lease expires without being renewed.
+type Watchdog interface {
+	reconcile.Reconciler
+	// Register registers the given cancel func for the cluster with the given name. The cancel func is
+	// called when the cluster's lease expires. A cancel func registered earlier for the same cluster is
+	// replaced without being called.
+	Register(clusterName string, cancel context.CancelFunc)
+	// Deregister removes the registration for the cluster with the given name and calls its cancel func.
+	// It is a no-op if no cancel func is registered for the cluster.
+	Deregister(clusterName string)
+}
+
+type clusterLeaseWatchdog struct {
+	logger logr.Logger
+	client client.Client
+
+	clustersToCheckMutex sync.Mutex
+	clustersToCheck      map[string]context.CancelFunc
+}
+
+// NewWatchdog creates a new cluster lease watchdog.
+func NewWatchdog() Watchdog {
+	return &clusterLeaseWatchdog{
+		logger:          log.Log.WithName(ControllerName),
+		clustersToCheck: map[string]context.CancelFunc{},
+	}
+}
+
+// InjectClient implements inject.Client.
+func (w *clusterLeaseWatchdog) InjectClient(client client.Client) error {
+	w.client = client
+	return nil
+}
+
+// Register registers the given cancel func for the cluster with the given name.
+func (w *clusterLeaseWatchdog) Register(clusterName string, cancel context.CancelFunc) {
+	w.clustersToCheckMutex.Lock()
+	defer w.clustersToCheckMutex.Unlock()
+
+	w.clustersToCheck[clusterName] = cancel
+}
+
+// Deregister removes the registration for the cluster with the given name and calls its cancel func.
+func (w *clusterLeaseWatchdog) Deregister(clusterName string) {
+	w.clustersToCheckMutex.Lock()
+	defer w.clustersToCheckMutex.Unlock()
+
+	if cancel, ok := w.clustersToCheck[clusterName]; ok {
+		cancel()
+		delete(w.clustersToCheck, clusterName)
+	}
+}
+
+// Reconcile checks the lease expiration of the requested Cluster. If the lease is still valid, the request
+// is requeued for the expiration time. If the lease has expired, the cancel func registered for the cluster
+// is called.
+func (w *clusterLeaseWatchdog) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := w.client.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The cluster is gone, make sure its registration does not leak.
+			w.Deregister(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.Spec.LeaseExpiration == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if remaining := time.Until(cluster.Spec.LeaseExpiration.Time); remaining > 0 {
+		// The lease is still valid, check again when it is due to expire.
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	w.clustersToCheckMutex.Lock()
+	defer w.clustersToCheckMutex.Unlock()
+
+	if cancel, ok := w.clustersToCheck[request.Name]; ok {
+		w.logger.Info("Cluster lease has expired, cancelling the registered context", "cluster", request.Name)
+		cancel()
+		delete(w.clustersToCheck, request.Name)
+	}
+
+	return reconcile.Result{}, nil
+}
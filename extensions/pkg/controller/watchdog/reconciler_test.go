@@ -0,0 +1,166 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/watchdog"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		watchdog   Watchdog
+
+		newCluster = func(name string, leaseExpiration *metav1.Time) *extensionsv1alpha1.Cluster {
+			return &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					LeaseExpiration: leaseExpiration,
+				},
+			}
+		}
+
+		requestFor = func(name string) reconcile.Request {
+			return reconcile.Request{NamespacedName: client.ObjectKey{Name: name}}
+		}
+	)
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		fakeClient = fake.NewClientBuilder().WithScheme(s).Build()
+
+		watchdog = NewWatchdog()
+		_, err := inject.ClientInto(fakeClient, watchdog)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#Reconcile", func() {
+		It("should requeue for the expiration time if the lease is still valid", func() {
+			leaseExpiration := metav1.NewTime(time.Now().Add(time.Hour))
+			Expect(fakeClient.Create(ctx, newCluster("foo", &leaseExpiration))).To(Succeed())
+
+			result, err := watchdog.Reconcile(ctx, requestFor("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		})
+
+		It("should call the registered cancel func if the lease has expired", func() {
+			leaseExpiration := metav1.NewTime(time.Now().Add(-time.Minute))
+			Expect(fakeClient.Create(ctx, newCluster("foo", &leaseExpiration))).To(Succeed())
+
+			var cancelled int32
+			watchdog.Register("foo", func() { atomic.AddInt32(&cancelled, 1) })
+
+			result, err := watchdog.Reconcile(ctx, requestFor("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(atomic.LoadInt32(&cancelled)).To(Equal(int32(1)))
+		})
+
+		It("should do nothing if the cluster does not exist", func() {
+			result, err := watchdog.Reconcile(ctx, requestFor("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+		})
+
+		It("should deregister a cluster that no longer exists", func() {
+			var cancelled int32
+			watchdog.Register("foo", func() { atomic.AddInt32(&cancelled, 1) })
+
+			_, err := watchdog.Reconcile(ctx, requestFor("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atomic.LoadInt32(&cancelled)).To(Equal(int32(1)))
+		})
+
+		It("should be safe for concurrent registration and reconciliation", func() {
+			var (
+				clusterCount = 10
+				cancelled    int32
+				wg           sync.WaitGroup
+			)
+
+			leaseExpiration := metav1.NewTime(time.Now().Add(-time.Minute))
+			for i := 0; i < clusterCount; i++ {
+				Expect(fakeClient.Create(ctx, newCluster(fmt.Sprintf("cluster-%d", i), &leaseExpiration))).To(Succeed())
+			}
+
+			for i := 0; i < clusterCount; i++ {
+				name := fmt.Sprintf("cluster-%d", i)
+
+				wg.Add(2)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					watchdog.Register(name, func() { atomic.AddInt32(&cancelled, 1) })
+				}()
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, err := watchdog.Reconcile(ctx, requestFor(name))
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			// Reconcile all clusters once more so that registrations that happened after the
+			// concurrent reconciliation are also picked up.
+			for i := 0; i < clusterCount; i++ {
+				_, err := watchdog.Reconcile(ctx, requestFor(fmt.Sprintf("cluster-%d", i)))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(atomic.LoadInt32(&cancelled)).To(Equal(int32(clusterCount)))
+		})
+	})
+
+	Describe("#Deregister", func() {
+		It("should call the cancel func of an existing registration and remove it", func() {
+			var cancelled int32
+			watchdog.Register("foo", func() { atomic.AddInt32(&cancelled, 1) })
+
+			watchdog.Deregister("foo")
+			Expect(atomic.LoadInt32(&cancelled)).To(Equal(int32(1)))
+
+			// A second deregistration must not call the cancel func again
+			watchdog.Deregister("foo")
+			Expect(atomic.LoadInt32(&cancelled)).To(Equal(int32(1)))
+		})
+
+		It("should be a no-op for an unknown cluster", func() {
+			Expect(func() { watchdog.Deregister("unknown") }).NotTo(Panic())
+		})
+	})
+})
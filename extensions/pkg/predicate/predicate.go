@@ -147,6 +147,21 @@ func LastOperationNotSuccessful() predicate.Predicate {
 	}
 }
 
+// GenerationOrOperationAnnotationChanged is a predicate for update events that only triggers when the object's
+// generation changed or when the gardener.cloud/operation annotation changed. Unrelated annotation-only updates
+// (e.g. timestamp bumps) are filtered out. Create, delete, and generic events are not affected.
+func GenerationOrOperationAnnotationChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return e.ObjectNew.GetGeneration() != e.ObjectOld.GetGeneration() ||
+				e.ObjectNew.GetAnnotations()[v1beta1constants.GardenerOperation] != e.ObjectOld.GetAnnotations()[v1beta1constants.GardenerOperation]
+		},
+	}
+}
+
 // IsDeleting is a predicate for objects having a deletion timestamp.
 func IsDeleting() predicate.Predicate {
 	return FromMapper(MapperFunc(func(e event.GenericEvent) bool {
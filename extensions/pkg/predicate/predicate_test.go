@@ -163,6 +163,54 @@ var _ = Describe("Predicate", func() {
 		version       = "1.18"
 	)
 
+	Describe("#GenerationOrOperationAnnotationChanged", func() {
+		var (
+			predicate = GenerationOrOperationAnnotationChanged()
+
+			newContainerRuntime = func(generation int64, annotations map[string]string) *extensionsv1alpha1.ContainerRuntime {
+				return &extensionsv1alpha1.ContainerRuntime{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation:  generation,
+						Annotations: annotations,
+					},
+				}
+			}
+		)
+
+		It("should filter an annotation-only update that changes neither generation nor operation", func() {
+			updateEvent := event.UpdateEvent{
+				ObjectOld: newContainerRuntime(1, map[string]string{"foo": "bar"}),
+				ObjectNew: newContainerRuntime(1, map[string]string{"foo": "baz"}),
+			}
+
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeFalse())
+		})
+
+		It("should match an update that changes the generation", func() {
+			updateEvent := event.UpdateEvent{
+				ObjectOld: newContainerRuntime(1, nil),
+				ObjectNew: newContainerRuntime(2, nil),
+			}
+
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeTrue())
+		})
+
+		It("should match an update that changes the operation annotation", func() {
+			updateEvent := event.UpdateEvent{
+				ObjectOld: newContainerRuntime(1, nil),
+				ObjectNew: newContainerRuntime(1, map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}),
+			}
+
+			gomega.Expect(predicate.Update(updateEvent)).To(gomega.BeTrue())
+		})
+
+		It("should match create events", func() {
+			createEvent := event.CreateEvent{Object: newContainerRuntime(1, nil)}
+
+			gomega.Expect(predicate.Create(createEvent)).To(gomega.BeTrue())
+		})
+	})
+
 	Describe("#ClusterShootProviderType", func() {
 		var decoder runtime.Decoder
 
@@ -47,6 +47,26 @@ func UnstructuredAccessor(u *unstructured.Unstructured) extensionsv1alpha1.Objec
 	return unstructuredAccessor{u}
 }
 
+// ValidateUnstructured checks that the GroupVersionKind of the given unstructured object is within the extensions
+// API group. Accessors operating on objects of a foreign group would silently return zero values for all fields,
+// masking bugs at the call site.
+func ValidateUnstructured(u *unstructured.Unstructured) error {
+	gvk := u.GroupVersionKind()
+	if gvk.Group != extensionsv1alpha1.SchemeGroupVersion.Group || len(gvk.Kind) == 0 {
+		return fmt.Errorf("object with GroupVersionKind %q is not an extensions resource", gvk)
+	}
+	return nil
+}
+
+// StrictUnstructuredAccessor is like UnstructuredAccessor but additionally validates that the given object's
+// GroupVersionKind is within the extensions API group, returning an error otherwise.
+func StrictUnstructuredAccessor(u *unstructured.Unstructured) (extensionsv1alpha1.Object, error) {
+	if err := ValidateUnstructured(u); err != nil {
+		return nil, err
+	}
+	return UnstructuredAccessor(u), nil
+}
+
 type unstructuredAccessor struct {
 	*unstructured.Unstructured
 }
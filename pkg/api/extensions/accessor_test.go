@@ -78,6 +78,31 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("#StrictUnstructuredAccessor", func() {
+		It("should return an accessor for an extensions resource", func() {
+			u := &unstructured.Unstructured{}
+			Expect(scheme.Convert(&extensionsv1alpha1.Infrastructure{}, u, nil)).To(Succeed())
+
+			acc, err := StrictUnstructuredAccessor(u)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acc).To(Equal(UnstructuredAccessor(u)))
+		})
+
+		It("should error for an unstructured object of a foreign group", func() {
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+
+			_, err := StrictUnstructuredAccessor(u)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error for an unstructured object without a kind", func() {
+			_, err := StrictUnstructuredAccessor(&unstructured.Unstructured{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Context("#UnstructuredAccessor", func() {
 		Context("#GetExtensionSpec", func() {
 			Describe("#GetExtensionType", func() {
@@ -153,6 +178,19 @@ var _ = Describe("Accessor", func() {
 					acc.SetLastOperation(lastOp)
 					Expect(acc.GetLastOperation()).To(Equal(lastOp))
 				})
+
+				It("should round-trip a fully specified last operation including time fields", func() {
+					lastOp := &gardencorev1beta1.LastOperation{
+						Description:    "processing",
+						Progress:       42,
+						State:          gardencorev1beta1.LastOperationStateProcessing,
+						Type:           gardencorev1beta1.LastOperationTypeReconcile,
+						LastUpdateTime: metav1.NewTime(metav1.Now().Round(time.Second)),
+					}
+					acc := mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{})
+					acc.SetLastOperation(lastOp)
+					Expect(acc.GetLastOperation()).To(Equal(lastOp))
+				})
 			})
 
 			Describe("#GetLastError", func() {
@@ -269,7 +307,7 @@ var _ = Describe("Accessor", func() {
 				})
 			})
 
-			Describe("#SetNamedResourceReferences", func() {
+			Describe("#SetResources", func() {
 				It("should set the named resource references", func() {
 					var (
 						acc                    = mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{})
@@ -282,6 +320,14 @@ var _ = Describe("Accessor", func() {
 									APIVersion: "v1",
 								},
 							},
+							{
+								Name: "test_name2",
+								ResourceRef: autoscalingv1.CrossVersionObjectReference{
+									Kind:       "ConfigMap",
+									Name:       "test_name2",
+									APIVersion: "v1",
+								},
+							},
 						}
 					)
 					acc.SetResources(namedResourceReference)
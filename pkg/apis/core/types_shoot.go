@@ -246,6 +246,10 @@ type Extension struct {
 	ProviderConfig *runtime.RawExtension
 	// Disabled allows to disable extensions that were marked as 'globally enabled' by Gardener administrators.
 	Disabled *bool
+	// Timeout is the maximum duration the reconciliation of the corresponding Extension resource in the shoot
+	// namespace may take. If not specified, the timeout from the extension's ControllerRegistration or the
+	// global default applies.
+	Timeout *metav1.Duration
 }
 
 //////////////////////////////////////////////////////////////////////////////////////////////////
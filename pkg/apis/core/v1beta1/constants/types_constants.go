@@ -144,6 +144,9 @@ const (
 	// GardenerOperationKeepalive is a constant for the value of the operation annotation describing an
 	// operation that extends the lifetime of the object having the operation annotation.
 	GardenerOperationKeepalive = "keepalive"
+	// GardenerOperationIgnore is a constant for the value of the operation annotation describing that the
+	// object should be temporarily ignored for reconciliation.
+	GardenerOperationIgnore = "ignore"
 
 	// DeprecatedGardenRole is the key for an annotation on a Kubernetes object indicating what it is used for.
 	//
@@ -296,6 +296,11 @@ type Extension struct {
 	// Disabled allows to disable extensions that were marked as 'globally enabled' by Gardener administrators.
 	// +optional
 	Disabled *bool `json:"disabled,omitempty" protobuf:"varint,3,opt,name=disabled"`
+	// Timeout is the maximum duration the reconciliation of the corresponding Extension resource in the shoot
+	// namespace may take. If not specified, the timeout from the extension's ControllerRegistration or the
+	// global default applies.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
 }
 
 //////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2510,6 +2510,7 @@ func autoConvert_v1beta1_Extension_To_core_Extension(in *Extension, out *core.Ex
 	out.Type = in.Type
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Disabled = (*bool)(unsafe.Pointer(in.Disabled))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
 }
 
@@ -2522,6 +2523,7 @@ func autoConvert_core_Extension_To_v1beta1_Extension(in *core.Extension, out *Ex
 	out.Type = in.Type
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Disabled = (*bool)(unsafe.Pointer(in.Disabled))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
 }
 
@@ -1224,6 +1224,11 @@ func (in *Extension) DeepCopyInto(out *Extension) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
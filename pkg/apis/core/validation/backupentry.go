@@ -58,6 +58,10 @@ func ValidateBackupEntrySpecUpdate(newSpec, oldSpec *core.BackupEntrySpec, fldPa
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.BucketName, oldSpec.BucketName, fldPath.Child("bucketName"))...)
 
+	if oldSpec.SeedName != nil && newSpec.SeedName == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seedName"), newSpec.SeedName, "seedName cannot be set to nil once set"))
+	}
+
 	return allErrs
 }
 
@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
 	. "github.com/onsi/ginkgo"
@@ -87,6 +88,37 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.bucketName"),
 			}))))
 		})
+
+		It("should allow setting the seedName", func() {
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Spec.SeedName = pointer.String("some-seed")
+
+			errorList := ValidateBackupEntryUpdate(newBackupEntry, backupEntry)
+
+			Expect(errorList).To(HaveLen(0))
+		})
+
+		It("should allow keeping the seedName unchanged", func() {
+			backupEntry.Spec.SeedName = pointer.String("some-seed")
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+
+			errorList := ValidateBackupEntryUpdate(newBackupEntry, backupEntry)
+
+			Expect(errorList).To(HaveLen(0))
+		})
+
+		It("should forbid clearing the seedName once set", func() {
+			backupEntry.Spec.SeedName = pointer.String("some-seed")
+			newBackupEntry := prepareBackupEntryForUpdate(backupEntry)
+			newBackupEntry.Spec.SeedName = nil
+
+			errorList := ValidateBackupEntryUpdate(newBackupEntry, backupEntry)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.seedName"),
+			}))))
+		})
 	})
 })
 
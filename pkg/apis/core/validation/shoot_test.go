@@ -734,6 +734,27 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		It("should forbid resources with a malformed apiVersion", func() {
+			ref := core.NamedResourceReference{
+				Name: "test",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					Kind:       "Secret",
+					Name:       "test-secret",
+					APIVersion: "malformed/api/version",
+				},
+			}
+			shoot.Spec.Resources = append(shoot.Spec.Resources, ref)
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.resources[0].resourceRef.apiVersion"),
+				})),
+			))
+		})
+
 		It("should allow resources w/ names and valid references", func() {
 			ref := core.NamedResourceReference{
 				Name: "test",
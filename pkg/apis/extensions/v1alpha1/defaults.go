@@ -0,0 +1,40 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+// DefaultDNSRecordTTL is the TTL assumed for DNS records if none is specified.
+const DefaultDNSRecordTTL int64 = 120
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_DNSRecord sets default values for DNSRecord objects.
+func SetDefaults_DNSRecord(obj *DNSRecord) {
+	if len(obj.Spec.RecordType) == 0 {
+		obj.Spec.RecordType = DNSRecordTypeA
+	}
+	if obj.Spec.TTL == nil {
+		obj.Spec.TTL = pointer.Int64(DefaultDNSRecordTTL)
+	}
+	if len(obj.Spec.SecretRef.Namespace) == 0 {
+		obj.Spec.SecretRef.Namespace = obj.Namespace
+	}
+}
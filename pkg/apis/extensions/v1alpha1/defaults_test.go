@@ -0,0 +1,107 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("DNSRecord defaulting", func() {
+		It("should default the record type and TTL of a minimal DNSRecord", func() {
+			obj := &extensionsv1alpha1.DNSRecord{
+				Spec: extensionsv1alpha1.DNSRecordSpec{
+					Name:   "foo.example.com",
+					Values: []string{"1.2.3.4"},
+				},
+			}
+
+			scheme.Default(obj)
+
+			Expect(obj.Spec.RecordType).To(Equal(extensionsv1alpha1.DNSRecordTypeA))
+			Expect(obj.Spec.TTL).To(PointTo(Equal(extensionsv1alpha1.DefaultDNSRecordTTL)))
+		})
+
+		It("should default the secret reference namespace to the object namespace", func() {
+			obj := &extensionsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--test--foo"},
+				Spec: extensionsv1alpha1.DNSRecordSpec{
+					SecretRef: corev1.SecretReference{Name: "dnsrecord-secret"},
+				},
+			}
+
+			scheme.Default(obj)
+
+			Expect(obj.Spec.SecretRef.Namespace).To(Equal("shoot--test--foo"))
+		})
+
+		It("should not overwrite an explicitly specified secret reference namespace", func() {
+			obj := &extensionsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--test--foo"},
+				Spec: extensionsv1alpha1.DNSRecordSpec{
+					SecretRef: corev1.SecretReference{Name: "dnsrecord-secret", Namespace: "garden"},
+				},
+			}
+
+			scheme.Default(obj)
+
+			Expect(obj.Spec.SecretRef.Namespace).To(Equal("garden"))
+		})
+
+		It("should not overwrite an explicitly specified record type and TTL", func() {
+			obj := &extensionsv1alpha1.DNSRecord{
+				Spec: extensionsv1alpha1.DNSRecordSpec{
+					Name:       "foo.example.com",
+					RecordType: extensionsv1alpha1.DNSRecordTypeTXT,
+					TTL:        pointer.Int64(300),
+					Values:     []string{"foo"},
+				},
+			}
+
+			scheme.Default(obj)
+
+			Expect(obj.Spec.RecordType).To(Equal(extensionsv1alpha1.DNSRecordTypeTXT))
+			Expect(obj.Spec.TTL).To(PointTo(Equal(int64(300))))
+		})
+
+		It("should default all items of a DNSRecordList", func() {
+			list := &extensionsv1alpha1.DNSRecordList{
+				Items: []extensionsv1alpha1.DNSRecord{
+					{Spec: extensionsv1alpha1.DNSRecordSpec{Name: "foo.example.com", Values: []string{"1.2.3.4"}}},
+				},
+			}
+
+			scheme.Default(list)
+
+			Expect(list.Items[0].Spec.RecordType).To(Equal(extensionsv1alpha1.DNSRecordTypeA))
+			Expect(list.Items[0].Spec.TTL).To(PointTo(Equal(extensionsv1alpha1.DefaultDNSRecordTTL)))
+		})
+	})
+})
@@ -16,6 +16,7 @@ package helper
 
 import (
 	"net"
+	"strings"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
@@ -40,10 +41,28 @@ func GetDNSRecordType(address string) extensionsv1alpha1.DNSRecordType {
 	}
 }
 
-// GetDNSRecordTTL returns the value of the given ttl, or 120 if nil.
+// GetDNSRecordTTL returns the value of the given ttl, or the default TTL if nil.
 func GetDNSRecordTTL(ttl *int64) int64 {
 	if ttl != nil {
 		return *ttl
 	}
-	return 120
+	return extensionsv1alpha1.DefaultDNSRecordTTL
+}
+
+// NormalizeDNSRecordValues returns the given DNS record values with one pair of surrounding double quotes stripped
+// from each value of TXT records. DNS providers quote TXT values themselves, so passing an already quoted value
+// would lead to double quoting. Values of other record types are returned unchanged.
+func NormalizeDNSRecordValues(recordType extensionsv1alpha1.DNSRecordType, values []string) []string {
+	if recordType != extensionsv1alpha1.DNSRecordTypeTXT {
+		return values
+	}
+
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+		normalized = append(normalized, value)
+	}
+	return normalized
 }
@@ -58,4 +58,15 @@ var _ = Describe("helper", func() {
 		Entry("nil value", nil, int64(120)),
 		Entry("non-nil value", pointer.Int64(300), int64(300)),
 	)
+
+	DescribeTable("#NormalizeDNSRecordValues",
+		func(recordType extensionsv1alpha1.DNSRecordType, values, expected []string) {
+			Expect(NormalizeDNSRecordValues(recordType, values)).To(Equal(expected))
+		},
+
+		Entry("quoted TXT value", extensionsv1alpha1.DNSRecordTypeTXT, []string{`"foo"`}, []string{"foo"}),
+		Entry("unquoted TXT value", extensionsv1alpha1.DNSRecordTypeTXT, []string{"foo"}, []string{"foo"}),
+		Entry("mixed TXT values", extensionsv1alpha1.DNSRecordTypeTXT, []string{`"foo"`, "bar"}, []string{"foo", "bar"}),
+		Entry("quoted value of a non-TXT record", extensionsv1alpha1.DNSRecordTypeCNAME, []string{`"foo"`}, []string{`"foo"`}),
+	)
 })
@@ -64,4 +64,12 @@ type ClusterSpec struct {
 	// +kubebuilder:validation:XPreserveUnknownFields
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Shoot runtime.RawExtension `json:"shoot"`
+	// LeaseExpiration is the time at which the gardenlet's lease for this cluster expires unless it is renewed
+	// before. It can be watched by controllers that need to react when the lease is about to run out.
+	// +optional
+	LeaseExpiration *metav1.Time `json:"leaseExpiration,omitempty"`
+	// LeaseHolderIdentity is the identity of the gardenlet currently holding the lease for this cluster. Ownership
+	// checks can compare it with their own identity to determine whether they are (still) responsible.
+	// +optional
+	LeaseHolderIdentity *string `json:"leaseHolderIdentity,omitempty"`
 }
@@ -88,6 +88,22 @@ type DNSRecordSpec struct {
 	// TTL is the time to live in seconds. Defaults to 120.
 	// +optional
 	TTL *int64 `json:"ttl,omitempty"`
+	// RoutingPolicy is an optional routing policy for the record values, e.g. weighted or latency-based routing.
+	// If not specified, a plain record without routing metadata is created.
+	// +optional
+	RoutingPolicy *DNSRecordRoutingPolicy `json:"routingPolicy,omitempty"`
+}
+
+// DNSRecordRoutingPolicy contains the routing policy type and per-value parameters of a DNSRecord.
+type DNSRecordRoutingPolicy struct {
+	// Type is the routing policy type. Only weighted and latency policies are currently supported.
+	Type DNSRecordRoutingPolicyType `json:"type"`
+	// Weights maps record values to their non-negative weights. Only relevant for weighted routing.
+	// +optional
+	Weights map[string]int64 `json:"weights,omitempty"`
+	// Region is the region associated with the record values. Only relevant for latency-based routing.
+	// +optional
+	Region *string `json:"region,omitempty"`
 }
 
 // DNSRecordStatus is the status of a DNSRecord resource.
@@ -99,6 +115,16 @@ type DNSRecordStatus struct {
 	Zone *string `json:"zone,omitempty"`
 }
 
+// DNSRecordRoutingPolicyType is a string alias.
+type DNSRecordRoutingPolicyType string
+
+const (
+	// DNSRecordRoutingPolicyWeighted specifies weighted routing of the record values.
+	DNSRecordRoutingPolicyWeighted DNSRecordRoutingPolicyType = "weighted"
+	// DNSRecordRoutingPolicyLatency specifies latency-based routing of the record values.
+	DNSRecordRoutingPolicyLatency DNSRecordRoutingPolicyType = "latency"
+)
+
 // DNSRecordType is a string alias.
 type DNSRecordType string
 
@@ -107,6 +133,8 @@ const (
 	DNSRecordTypeA DNSRecordType = "A"
 	// DNSRecordTypeCNAME specifies that the DNSRecord is of type CNAME.
 	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	// DNSRecordTypeNS specifies that the DNSRecord is of type NS.
+	DNSRecordTypeNS DNSRecordType = "NS"
 	// DNSRecordTypeTXT specifies that the DNSRecord is of type TXT.
 	DNSRecordTypeTXT DNSRecordType = "TXT"
 )
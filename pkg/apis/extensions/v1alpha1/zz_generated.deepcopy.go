@@ -452,6 +452,15 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	in.CloudProfile.DeepCopyInto(&out.CloudProfile)
 	in.Seed.DeepCopyInto(&out.Seed)
 	in.Shoot.DeepCopyInto(&out.Shoot)
+	if in.LeaseExpiration != nil {
+		in, out := &in.LeaseExpiration, &out.LeaseExpiration
+		*out = (*in).DeepCopy()
+	}
+	if in.LeaseHolderIdentity != nil {
+		in, out := &in.LeaseHolderIdentity, &out.LeaseHolderIdentity
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -745,6 +754,34 @@ func (in *DNSRecordList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordRoutingPolicy) DeepCopyInto(out *DNSRecordRoutingPolicy) {
+	*out = *in
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordRoutingPolicy.
+func (in *DNSRecordRoutingPolicy) DeepCopy() *DNSRecordRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 	*out = *in
@@ -770,6 +807,11 @@ func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.RoutingPolicy != nil {
+		in, out := &in.RoutingPolicy, &out.RoutingPolicy
+		*out = new(DNSRecordRoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -15,6 +15,11 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 
@@ -24,11 +29,85 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+var (
+	regionPatternsLock sync.RWMutex
+	regionPatterns     = map[string]*regexp.Regexp{}
+
+	minimumTTLsLock sync.RWMutex
+	minimumTTLs     = map[string]int64{}
+)
+
+// RegisterRegionPattern registers a regular expression describing valid region identifiers for the given provider
+// type. ValidateDNSRecordSpec consults the registered pattern when spec.type matches; for unregistered types only
+// the non-empty check applies.
+func RegisterRegionPattern(providerType string, pattern *regexp.Regexp) {
+	regionPatternsLock.Lock()
+	defer regionPatternsLock.Unlock()
+
+	regionPatterns[providerType] = pattern
+}
+
+func regionPattern(providerType string) *regexp.Regexp {
+	regionPatternsLock.RLock()
+	defer regionPatternsLock.RUnlock()
+
+	return regionPatterns[providerType]
+}
+
+// RegisterMinimumTTL registers the minimum TTL in seconds enforced by the given provider type.
+// ValidateDNSRecordSpec rejects TTLs below the registered minimum when spec.type matches; for unregistered
+// types only the non-negative check applies.
+func RegisterMinimumTTL(providerType string, ttl int64) {
+	minimumTTLsLock.Lock()
+	defer minimumTTLsLock.Unlock()
+
+	minimumTTLs[providerType] = ttl
+}
+
+func minimumTTL(providerType string) (int64, bool) {
+	minimumTTLsLock.RLock()
+	defer minimumTTLsLock.RUnlock()
+
+	ttl, ok := minimumTTLs[providerType]
+	return ttl, ok
+}
+
+const (
+	// DNSRecordFamilyIPv4 is the family of DNSRecords resolving to IPv4 addresses (A records).
+	DNSRecordFamilyIPv4 = "ipv4"
+	// DNSRecordFamilyIPv6 is the family of DNSRecords resolving to IPv6 addresses (AAAA records, once supported).
+	DNSRecordFamilyIPv6 = "ipv6"
+	// DNSRecordFamilyName is the family of DNSRecords resolving to other domain names (CNAME records).
+	DNSRecordFamilyName = "name"
+	// DNSRecordFamilyText is the family of DNSRecords holding arbitrary text (TXT records).
+	DNSRecordFamilyText = "text"
+)
+
+// ClassifyDNSRecord validates the given DNSRecord and returns the family of its record type in one pass, so that
+// callers choosing provider code paths do not need to re-derive it from the record type after validation.
+func ClassifyDNSRecord(dns *extensionsv1alpha1.DNSRecord) (string, error) {
+	if errs := ValidateDNSRecord(dns); len(errs) > 0 {
+		return "", errs.ToAggregate()
+	}
+
+	switch dns.Spec.RecordType {
+	case extensionsv1alpha1.DNSRecordTypeA:
+		return DNSRecordFamilyIPv4, nil
+	case extensionsv1alpha1.DNSRecordTypeCNAME, extensionsv1alpha1.DNSRecordTypeNS:
+		return DNSRecordFamilyName, nil
+	case extensionsv1alpha1.DNSRecordTypeTXT:
+		return DNSRecordFamilyText, nil
+	}
+
+	// Unreachable as long as validation covers all supported record types
+	return "", fmt.Errorf("unknown record type %q", dns.Spec.RecordType)
+}
+
 // ValidateDNSRecord validates a DNSRecord object.
 func ValidateDNSRecord(dns *extensionsv1alpha1.DNSRecord) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&dns.ObjectMeta, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, ValidateDNSRecordSpec(&dns.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateDNSRecordSpec(&dns.Spec, dns.DeletionTimestamp != nil, field.NewPath("spec"))...)
 
 	return allErrs
 }
@@ -44,8 +123,10 @@ func ValidateDNSRecordUpdate(new, old *extensionsv1alpha1.DNSRecord) field.Error
 	return allErrs
 }
 
-// ValidateDNSRecordSpec validates the specification of a DNSRecord object.
-func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, fldPath *field.Path) field.ErrorList {
+// ValidateDNSRecordSpec validates the specification of a DNSRecord object. During deletion
+// (deletionTimestampSet is true) the values may legitimately be cleared by a controller, so they are
+// not required in that case.
+func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(spec.Type) == 0 {
@@ -56,8 +137,17 @@ func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, fldPath *fiel
 		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "field is required"))
 	}
 
-	if spec.Region != nil && len(*spec.Region) == 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *spec.Region, "field cannot be empty if specified"))
+	// The namespace is defaulted to the object namespace, so it must be set once the object has passed defaulting.
+	if len(spec.SecretRef.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "namespace"), "field is required"))
+	}
+
+	if spec.Region != nil {
+		if len(*spec.Region) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *spec.Region, "field cannot be empty if specified"))
+		} else if pattern := regionPattern(spec.Type); pattern != nil && !pattern.MatchString(*spec.Region) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *spec.Region, fmt.Sprintf("region does not match the expected format for provider type %q (%s)", spec.Type, pattern)))
+		}
 	}
 
 	if spec.Zone != nil && len(*spec.Zone) == 0 {
@@ -65,25 +155,44 @@ func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, fldPath *fiel
 	}
 
 	// This will return FieldValueRequired for an empty spec.Name
-	allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath.Child("name"), spec.Name)...)
+	allErrs = append(allErrs, validateName(spec.RecordType, spec.Name, fldPath.Child("name"))...)
 
-	validRecordTypes := []string{string(extensionsv1alpha1.DNSRecordTypeA), string(extensionsv1alpha1.DNSRecordTypeCNAME), string(extensionsv1alpha1.DNSRecordTypeTXT)}
+	validRecordTypes := []string{string(extensionsv1alpha1.DNSRecordTypeA), string(extensionsv1alpha1.DNSRecordTypeCNAME), string(extensionsv1alpha1.DNSRecordTypeNS), string(extensionsv1alpha1.DNSRecordTypeTXT)}
 	if !utils.ValueExists(string(spec.RecordType), validRecordTypes) {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("recordType"), spec.RecordType, validRecordTypes))
 	}
 
-	if len(spec.Values) == 0 {
+	if len(spec.Values) == 0 && !deletionTimestampSet {
 		allErrs = append(allErrs, field.Required(fldPath.Child("values"), "field is required"))
 	}
-	if spec.RecordType == extensionsv1alpha1.DNSRecordTypeCNAME && len(spec.Values) > 1 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("values"), spec.Values, "CNAME records must have a single value"))
-	}
-	for i, value := range spec.Values {
-		allErrs = append(allErrs, validateValue(spec.RecordType, value, fldPath.Child("values").Index(i))...)
-	}
+	allErrs = append(allErrs, validateValuesForType(spec.RecordType, spec.Values, fldPath.Child("values"))...)
 
 	if spec.TTL != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*spec.TTL, fldPath.Child("ttl"))...)
+		if min, ok := minimumTTL(spec.Type); ok && *spec.TTL < min {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), *spec.TTL, fmt.Sprintf("ttl must be at least %d seconds for provider type %q", min, spec.Type)))
+		}
+	}
+
+	if spec.RoutingPolicy != nil {
+		allErrs = append(allErrs, validateRoutingPolicy(spec.RoutingPolicy, fldPath.Child("routingPolicy"))...)
+	}
+
+	return allErrs
+}
+
+func validateRoutingPolicy(policy *extensionsv1alpha1.DNSRecordRoutingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validPolicyTypes := []string{string(extensionsv1alpha1.DNSRecordRoutingPolicyWeighted), string(extensionsv1alpha1.DNSRecordRoutingPolicyLatency)}
+	if !utils.ValueExists(string(policy.Type), validPolicyTypes) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), policy.Type, validPolicyTypes))
+	}
+
+	for value, weight := range policy.Weights {
+		if weight < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("weights").Key(value), weight, "weight must be non-negative"))
+		}
 	}
 
 	return allErrs
@@ -101,6 +210,9 @@ func ValidateDNSRecordSpecUpdate(new, old *extensionsv1alpha1.DNSRecordSpec, del
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Name, old.Name, fldPath.Child("name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.RecordType, old.RecordType, fldPath.Child("recordType"))...)
+	if new.RoutingPolicy != nil && old.RoutingPolicy != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.RoutingPolicy.Type, old.RoutingPolicy.Type, fldPath.Child("routingPolicy", "type"))...)
+	}
 
 	return allErrs
 }
@@ -119,13 +231,90 @@ func ValidateDNSRecordStatusUpdate(newStatus, oldStatus extensionsv1alpha1.DNSRe
 	return allErrs
 }
 
-func validateValue(recordType extensionsv1alpha1.DNSRecordType, value string, fldPath *field.Path) field.ErrorList {
+const (
+	// maxLabelLength is the maximum length in bytes of a single DNS label.
+	maxLabelLength = 63
+	// maxNameLength is the maximum total length in bytes of a DNS name.
+	maxNameLength = 253
+)
+
+// validateName validates the name of a DNSRecord object. For A and CNAME records a single leading wildcard
+// label is allowed, the rest of the name must be a valid FQDN in any case (embedded wildcards are rejected
+// by the FQDN validation).
+func validateName(recordType extensionsv1alpha1.DNSRecordType, name string, fldPath *field.Path) field.ErrorList {
+	// If the length limits are exceeded, return only the specific errors instead of an additional generic
+	// "too long" error from the FQDN validation.
+	if allErrs := validateNameLength(name, fldPath); len(allErrs) > 0 {
+		return allErrs
+	}
+	if recordType == extensionsv1alpha1.DNSRecordTypeA || recordType == extensionsv1alpha1.DNSRecordTypeCNAME {
+		name = strings.TrimPrefix(name, "*.")
+	}
+	return validation.IsFullyQualifiedDomainName(fldPath, name)
+}
+
+// validateNameLength validates the DNS length limits of the given name, i.e. at most 63 bytes per label and
+// 253 bytes in total. Overlong names would only fail at the provider otherwise.
+func validateNameLength(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, label := range strings.Split(name, ".") {
+		if len(label) > maxLabelLength {
+			allErrs = append(allErrs, field.TooLong(fldPath.Index(i), label, maxLabelLength))
+		}
+	}
+	if len(name) > maxNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, name, maxNameLength))
+	}
+	return allErrs
+}
+
+// validateValuesForType validates the values of a DNSRecord object against its record type. All per-type
+// constraints live in this single dispatch, both the shape of the individual values and cardinality
+// constraints such as CNAME records allowing only a single value.
+func validateValuesForType(recordType extensionsv1alpha1.DNSRecordType, values []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	switch recordType {
 	case extensionsv1alpha1.DNSRecordTypeA:
-		allErrs = append(allErrs, validation.IsValidIPv4Address(fldPath, value)...)
+		for i, value := range values {
+			allErrs = append(allErrs, validation.IsValidIPv4Address(fldPath.Index(i), value)...)
+		}
 	case extensionsv1alpha1.DNSRecordTypeCNAME:
-		allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath, value)...)
+		if len(values) > 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath, values, "CNAME records must have a single value"))
+		}
+		for i, value := range values {
+			allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath.Index(i), value)...)
+		}
+	case extensionsv1alpha1.DNSRecordTypeNS:
+		for i, value := range values {
+			allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath.Index(i), value)...)
+		}
+	case extensionsv1alpha1.DNSRecordTypeTXT:
+		for i, value := range values {
+			// One pair of surrounding quotes is tolerated (and stripped by normalization), any other unescaped
+			// quote would result in malformed quoting at the DNS provider.
+			trimmed := value
+			if len(trimmed) >= 2 && strings.HasPrefix(trimmed, `"`) && strings.HasSuffix(trimmed, `"`) {
+				trimmed = trimmed[1 : len(trimmed)-1]
+			}
+			if containsUnescapedQuote(trimmed) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), value, "value contains unbalanced or unescaped double quotes"))
+			}
+		}
 	}
 	return allErrs
 }
+
+// containsUnescapedQuote checks if the given string contains a double quote that is not escaped with a backslash.
+// TXT values are quoted by the DNS provider, so such a quote would result in malformed quoting of the record.
+func containsUnescapedQuote(value string) bool {
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case '"':
+			return true
+		}
+	}
+	return false
+}
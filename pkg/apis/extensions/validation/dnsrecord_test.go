@@ -15,12 +15,17 @@
 package validation_test
 
 import (
+	"regexp"
+	"strings"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -41,7 +46,8 @@ var _ = Describe("DNSRecord validation tests", func() {
 					Type: "provider",
 				},
 				SecretRef: corev1.SecretReference{
-					Name: "test",
+					Name:      "test",
+					Namespace: "test-namespace",
 				},
 				Name:       "test.example.com",
 				RecordType: extensionsv1alpha1.DNSRecordTypeA,
@@ -66,6 +72,9 @@ var _ = Describe("DNSRecord validation tests", func() {
 			})), PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeRequired),
 				"Field": Equal("spec.secretRef.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.secretRef.namespace"),
 			})), PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeRequired),
 				"Field": Equal("spec.name"),
@@ -78,6 +87,84 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
+		Context("with a registered region pattern", func() {
+			BeforeEach(func() {
+				dns.Spec.Type = "pattern-provider"
+				RegisterRegionPattern("pattern-provider", regexp.MustCompile(`^[a-z]+-[a-z]+-\d$`))
+			})
+
+			It("should allow a region matching the registered pattern", func() {
+				dns.Spec.Region = pointer.String("eu-west-1")
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a region not matching the registered pattern", func() {
+				dns.Spec.Region = pointer.String("somewhere")
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.region"),
+				}))))
+			})
+
+			It("should keep the non-empty check for unregistered types", func() {
+				dns.Spec.Type = "other-provider"
+				dns.Spec.Region = pointer.String("somewhere")
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
+		Context("with a registered minimum TTL", func() {
+			BeforeEach(func() {
+				dns.Spec.Type = "ttl-provider"
+				RegisterMinimumTTL("ttl-provider", 300)
+			})
+
+			It("should forbid a ttl below the registered minimum", func() {
+				dns.Spec.TTL = pointer.Int64(60)
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.ttl"),
+				}))))
+			})
+
+			It("should allow a ttl at the registered minimum", func() {
+				dns.Spec.TTL = pointer.Int64(300)
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow a ttl above the registered minimum", func() {
+				dns.Spec.TTL = pointer.Int64(3600)
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should keep the non-negative check for unregistered types", func() {
+				dns.Spec.Type = "other-provider"
+				dns.Spec.TTL = pointer.Int64(60)
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
 		It("should forbid non-nil but empty region or zone", func() {
 			dns.Spec.Region = pointer.String("")
 			dns.Spec.Zone = pointer.String("")
@@ -104,52 +191,105 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
-		It("should forbid unsupported recordType values", func() {
-			dns.Spec.RecordType = "AAAA"
+		It("should allow a name with a single leading wildcard label", func() {
+			dns.Spec.Name = "*.api.shoot.example.com"
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid a name with an embedded wildcard label", func() {
+			dns.Spec.Name = "api.*.shoot.example.com"
 
 			errorList := ValidateDNSRecord(dns)
 
 			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeNotSupported),
-				"Field": Equal("spec.recordType"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.name"),
 			}))))
 		})
 
-		It("should forbid type CNAME and more than 1 value", func() {
-			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
-			dns.Spec.Values = []string{"example.com", "foo.bar"}
+		It("should forbid a name with an overlong label", func() {
+			dns.Spec.Name = strings.Repeat("a", 64) + ".shoot.example.com"
 
 			errorList := ValidateDNSRecord(dns)
 
 			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("spec.values"),
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("spec.name[0]"),
 			}))))
 		})
 
-		It("should forbid type A and a value that is not a valid IPv4 address", func() {
-			dns.Spec.Values = []string{"example.com"}
+		It("should forbid an overlong name", func() {
+			dns.Spec.Name = strings.TrimPrefix(strings.Repeat("."+strings.Repeat("a", 50), 5), ".") + ".example.com"
 
 			errorList := ValidateDNSRecord(dns)
 
 			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("spec.values[0]"),
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("spec.name"),
 			}))))
 		})
 
-		It("should forbid type CNAME and a value that is not a valid FQDN", func() {
-			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
-			dns.Spec.Values = []string{"example"}
+		It("should forbid a wildcard name for TXT records", func() {
+			dns.Spec.Name = "*.api.shoot.example.com"
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeTXT
+			dns.Spec.Values = []string{"foo"}
 
 			errorList := ValidateDNSRecord(dns)
 
 			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("spec.values[0]"),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		It("should forbid unsupported recordType values", func() {
+			dns.Spec.RecordType = "AAAA"
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.recordType"),
 			}))))
 		})
 
+		DescribeTable("values matching the record type",
+			func(recordType extensionsv1alpha1.DNSRecordType, values []string, matcher gomegatypes.GomegaMatcher) {
+				dns.Spec.RecordType = recordType
+				dns.Spec.Values = values
+
+				errorList := ValidateDNSRecord(dns)
+
+				Expect(errorList).To(matcher)
+			},
+
+			Entry("type A and valid IPv4 addresses",
+				extensionsv1alpha1.DNSRecordTypeA, []string{"1.2.3.4", "5.6.7.8"}, BeEmpty()),
+			Entry("type A and a value that is not a valid IPv4 address",
+				extensionsv1alpha1.DNSRecordTypeA, []string{"example.com"}, matchInvalidValue("spec.values[0]")),
+			Entry("type CNAME and a single FQDN value",
+				extensionsv1alpha1.DNSRecordTypeCNAME, []string{"example.com"}, BeEmpty()),
+			Entry("type CNAME and more than 1 value",
+				extensionsv1alpha1.DNSRecordTypeCNAME, []string{"example.com", "foo.bar"}, matchInvalidValue("spec.values")),
+			Entry("type CNAME and a value that is not a valid FQDN",
+				extensionsv1alpha1.DNSRecordTypeCNAME, []string{"example"}, matchInvalidValue("spec.values[0]")),
+			Entry("type NS and multiple FQDN values",
+				extensionsv1alpha1.DNSRecordTypeNS, []string{"ns1.example.com", "ns2.example.com"}, BeEmpty()),
+			Entry("type NS and a value that is not a valid FQDN",
+				extensionsv1alpha1.DNSRecordTypeNS, []string{"ns1.example.com", "ns2"}, matchInvalidValue("spec.values[1]")),
+			Entry("type TXT and arbitrary text values",
+				extensionsv1alpha1.DNSRecordTypeTXT, []string{"can be anything"}, BeEmpty()),
+			Entry("type TXT and quoted and escaped values",
+				extensionsv1alpha1.DNSRecordTypeTXT, []string{`"fully quoted"`, `contains \"escaped\" quotes`}, BeEmpty()),
+			Entry("type TXT and a value with unbalanced quotes",
+				extensionsv1alpha1.DNSRecordTypeTXT, []string{`"unbalanced`}, matchInvalidValue("spec.values[0]")),
+			Entry("type TXT and a value with embedded unescaped quotes",
+				extensionsv1alpha1.DNSRecordTypeTXT, []string{`foo"bar`}, matchInvalidValue("spec.values[0]")),
+		)
+
 		It("should forbid negative ttl", func() {
 			dns.Spec.TTL = pointer.Int64(-1)
 
@@ -161,6 +301,27 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
+		It("should forbid empty values if no deletion timestamp is set", func() {
+			dns.Spec.Values = nil
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.values"),
+			}))))
+		})
+
+		It("should allow empty values if a deletion timestamp is set", func() {
+			now := metav1.Now()
+			dns.DeletionTimestamp = &now
+			dns.Spec.Values = nil
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should allow valid resources (type A)", func() {
 			errorList := ValidateDNSRecord(dns)
 
@@ -176,14 +337,88 @@ var _ = Describe("DNSRecord validation tests", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
-		It("should allow valid resources (type TXT)", func() {
-			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeTXT
-			dns.Spec.Values = []string{"can be anything"}
+		It("should allow a weighted routing policy with valid weights", func() {
+			dns.Spec.Values = []string{"1.2.3.4", "5.6.7.8"}
+			dns.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type: extensionsv1alpha1.DNSRecordRoutingPolicyWeighted,
+				Weights: map[string]int64{
+					"1.2.3.4": 1,
+					"5.6.7.8": 3,
+				},
+			}
 
 			errorList := ValidateDNSRecord(dns)
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid a weighted routing policy with negative weights", func() {
+			dns.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type: extensionsv1alpha1.DNSRecordRoutingPolicyWeighted,
+				Weights: map[string]int64{
+					"1.2.3.4": -1,
+				},
+			}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.routingPolicy.weights[1.2.3.4]"),
+			}))))
+		})
+
+		It("should forbid unsupported routing policy types", func() {
+			dns.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type: "geolocation",
+			}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.routingPolicy.type"),
+			}))))
+		})
+
+	})
+
+	Describe("#ClassifyDNSRecord", func() {
+		It("should classify A records as ipv4", func() {
+			family, err := ClassifyDNSRecord(dns)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(family).To(Equal(DNSRecordFamilyIPv4))
+		})
+
+		It("should classify CNAME records as name", func() {
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
+			dns.Spec.Values = []string{"other.example.com"}
+
+			family, err := ClassifyDNSRecord(dns)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(family).To(Equal(DNSRecordFamilyName))
+		})
+
+		It("should classify TXT records as text", func() {
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeTXT
+			dns.Spec.Values = []string{"can be anything"}
+
+			family, err := ClassifyDNSRecord(dns)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(family).To(Equal(DNSRecordFamilyText))
+		})
+
+		It("should return the validation errors for an invalid DNSRecord", func() {
+			dns.Spec.RecordType = "AAAA"
+
+			family, err := ClassifyDNSRecord(dns)
+
+			Expect(err).To(MatchError(ContainSubstring("recordType")))
+			Expect(family).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidateDNSRecordUpdate", func() {
@@ -222,6 +457,27 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
+		It("should prevent updating the routing policy type", func() {
+			dns.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type: extensionsv1alpha1.DNSRecordRoutingPolicyWeighted,
+				Weights: map[string]int64{
+					"1.2.3.4": 1,
+				},
+			}
+			newDNSRecord := prepareDNSRecordForUpdate(dns)
+			newDNSRecord.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type:   extensionsv1alpha1.DNSRecordRoutingPolicyLatency,
+				Region: pointer.String("eu-west-1"),
+			}
+
+			errorList := ValidateDNSRecordUpdate(newDNSRecord, dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.routingPolicy.type"),
+			}))))
+		})
+
 		It("should allow updating everything else", func() {
 			newDNSRecord := prepareDNSRecordForUpdate(dns)
 			newDNSRecord.Spec.SecretRef.Name = "changed-secretref-name"
@@ -229,6 +485,12 @@ var _ = Describe("DNSRecord validation tests", func() {
 			newDNSRecord.Spec.Zone = pointer.String("zone")
 			newDNSRecord.Spec.Values = []string{"5.6.7.8"}
 			newDNSRecord.Spec.TTL = pointer.Int64(300)
+			newDNSRecord.Spec.RoutingPolicy = &extensionsv1alpha1.DNSRecordRoutingPolicy{
+				Type: extensionsv1alpha1.DNSRecordRoutingPolicyWeighted,
+				Weights: map[string]int64{
+					"5.6.7.8": 2,
+				},
+			}
 
 			errorList := ValidateDNSRecordUpdate(newDNSRecord, dns)
 
@@ -237,6 +499,13 @@ var _ = Describe("DNSRecord validation tests", func() {
 	})
 })
 
+func matchInvalidValue(fieldPath string) gomegatypes.GomegaMatcher {
+	return ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+		"Type":  Equal(field.ErrorTypeInvalid),
+		"Field": Equal(fieldPath),
+	})))
+}
+
 func prepareDNSRecordForUpdate(obj *extensionsv1alpha1.DNSRecord) *extensionsv1alpha1.DNSRecord {
 	newObj := obj.DeepCopy()
 	newObj.ResourceVersion = "1"
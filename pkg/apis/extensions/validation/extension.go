@@ -15,6 +15,7 @@
 package validation
 
 import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -67,6 +68,26 @@ func ValidateExtensionSpecUpdate(new, old *extensionsv1alpha1.ExtensionSpec, del
 	return allErrs
 }
 
+// ValidateExtensionResourceReferences checks that all named resource references carried by the given Extension
+// resolve to entries in the given list of available named references, flagging dangling names.
+func ValidateExtensionResourceReferences(ext *extensionsv1alpha1.Extension, availableResources []gardencorev1beta1.NamedResourceReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	availableNames := map[string]struct{}{}
+	for _, resource := range availableResources {
+		availableNames[resource.Name] = struct{}{}
+	}
+
+	fldPath := field.NewPath("status", "resources")
+	for i, resource := range ext.Status.Resources {
+		if _, ok := availableNames[resource.Name]; !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), resource.Name, "does not reference an available named resource reference"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateExtensionStatus validates the status of a Extension object.
 func ValidateExtensionStatus(spec *extensionsv1alpha1.ExtensionStatus, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -15,12 +15,14 @@
 package validation_test
 
 import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -67,6 +69,41 @@ var _ = Describe("Extension validation tests", func() {
 		})
 	})
 
+	Describe("#ValidateExtensionResourceReferences", func() {
+		var availableResources []gardencorev1beta1.NamedResourceReference
+
+		BeforeEach(func() {
+			availableResources = []gardencorev1beta1.NamedResourceReference{
+				{
+					Name: "foo",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{
+						Kind:       "Secret",
+						Name:       "foo-secret",
+						APIVersion: "v1",
+					},
+				},
+			}
+			ext.Status.Resources = []gardencorev1beta1.NamedResourceReference{availableResources[0]}
+		})
+
+		It("should allow resource references that resolve to available references", func() {
+			errorList := ValidateExtensionResourceReferences(ext, availableResources)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid dangling resource references", func() {
+			ext.Status.Resources[0].Name = "dangling"
+
+			errorList := ValidateExtensionResourceReferences(ext, availableResources)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.resources[0].name"),
+			}))))
+		})
+	})
+
 	Describe("#ValidExtensionUpdate", func() {
 		It("should prevent updating anything if deletion time stamp is set", func() {
 			now := metav1.Now()
@@ -245,8 +245,13 @@ func computeKindTypesForShoots(
 				logger.Warnf("could not determine external domain for shoot %s/%s: %+v", shoot.Namespace, shoot.Name, err)
 			}
 
+			var defaultDomain *gardenpkg.Domain
+			if shoot.Spec.DNS != nil && shoot.Spec.DNS.Domain != nil {
+				defaultDomain = gardenpkg.DomainIsDefaultDomain(*shoot.Spec.DNS.Domain, defaultDomains)
+			}
+
 			out <- shootpkg.ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain,
-				controllermanagerfeatures.FeatureGate.Enabled(features.UseDNSRecords))
+				defaultDomain, controllermanagerfeatures.FeatureGate.Enabled(features.UseDNSRecords))
 		}(shoot.DeepCopy())
 	}
 
@@ -45,6 +45,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/garden"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -201,7 +202,7 @@ func (f *GardenControllerFactory) Run(ctx context.Context) error {
 	}
 
 	// Initialize the Controller metrics collection.
-	gardenmetrics.RegisterControllerMetrics(controllermanager.ControllerWorkerSum, controllermanager.ScrapeFailures, metricsCollectors...)
+	gardenmetrics.RegisterControllerMetrics([]*prometheus.Desc{controllermanager.ControllerWorkerSum}, controllermanager.ScrapeFailures, metricsCollectors...)
 
 	logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Get().GitVersion)
 
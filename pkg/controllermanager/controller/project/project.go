@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/logger"
+	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 
 	"github.com/prometheus/client_golang/prometheus"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -37,9 +38,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// projectNameResolverTTL is the duration for which resolved project names are cached. RoleBinding events may
+// arrive in bursts for the same namespace, so a short TTL avoids listing Project objects for every single event.
+const projectNameResolverTTL = 30 * time.Second
+
 // Controller controls Projects.
 type Controller struct {
-	gardenClient client.Client
+	gardenClient        client.Client
+	projectNameResolver *gutil.ProjectNameResolver
 
 	projectReconciler      reconcile.Reconciler
 	projectStaleReconciler reconcile.Reconciler
@@ -79,6 +85,7 @@ func NewProjectController(
 
 	projectController := &Controller{
 		gardenClient:           gardenClient.Client(),
+		projectNameResolver:    gutil.NewProjectNameResolver(gardenClient.Client(), projectNameResolverTTL),
 		projectReconciler:      NewProjectReconciler(logger.Logger, config.Controllers.Project, gardenClient, recorder),
 		projectStaleReconciler: NewProjectStaleReconciler(logger.Logger, config.Controllers.Project, gardenClient.Client()),
 		projectQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Project"),
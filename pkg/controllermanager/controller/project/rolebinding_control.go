@@ -19,9 +19,10 @@ import (
 	"strings"
 
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
-	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 func (c *Controller) roleBindingUpdate(ctx context.Context, _, new interface{}) {
@@ -47,12 +48,21 @@ func (c *Controller) roleBindingDelete(ctx context.Context, obj interface{}) {
 
 		logger.Logger.Debugf("[PROJECT RECONCILE] %q rolebinding modified", key)
 
-		project, err := gutil.ProjectForNamespaceFromReader(ctx, c.gardenClient, namespace)
+		// Resolve the project name via the TTL cache so that bursts of rolebinding events for the same
+		// namespace do not list Project objects over and over again. Only the deletion check still needs
+		// the Project object, which is a cheap Get by the resolved name.
+		projectName, err := c.projectNameResolver.ProjectName(ctx, namespace)
 		if err != nil {
 			logger.Logger.Errorf("Couldn't get list keys for object %+v: %v", obj, err)
 			return
 		}
 
+		project := &gardencorev1beta1.Project{}
+		if err := c.gardenClient.Get(ctx, client.ObjectKey{Name: projectName}, project); err != nil {
+			logger.Logger.Errorf("Couldn't get project %q for object %+v: %v", projectName, obj, err)
+			return
+		}
+
 		if project.DeletionTimestamp == nil {
 			c.projectQueue.Add(project.Name)
 		}
@@ -20,6 +20,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -64,8 +65,9 @@ var _ = Describe("#roleBindingDelete", func() {
 			ObjectMeta: metav1.ObjectMeta{Name: "role-1", Namespace: ns},
 		}
 		controller = &Controller{
-			gardenClient: c,
-			projectQueue: queue,
+			gardenClient:        c,
+			projectNameResolver: gutil.NewProjectNameResolver(c, projectNameResolverTTL),
+			projectQueue:        queue,
 		}
 	})
 
@@ -88,6 +90,10 @@ var _ = Describe("#roleBindingDelete", func() {
 				(&gardencorev1beta1.ProjectList{Items: []gardencorev1beta1.Project{*proj}}).DeepCopyInto(list)
 				return nil
 			})
+			c.EXPECT().Get(ctx, client.ObjectKey{Name: proj.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.Project{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *gardencorev1beta1.Project) error {
+				proj.DeepCopyInto(actual)
+				return nil
+			})
 
 			controller.roleBindingDelete(ctx, rolebinding)
 
@@ -111,6 +117,10 @@ var _ = Describe("#roleBindingDelete", func() {
 				(&gardencorev1beta1.ProjectList{Items: []gardencorev1beta1.Project{*proj}}).DeepCopyInto(list)
 				return nil
 			})
+			c.EXPECT().Get(ctx, client.ObjectKey{Name: proj.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.Project{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *gardencorev1beta1.Project) error {
+				proj.DeepCopyInto(actual)
+				return nil
+			})
 
 			controller.roleBindingDelete(ctx, rolebinding)
 
@@ -121,4 +131,22 @@ var _ = Describe("#roleBindingDelete", func() {
 		Entry("project-viewer", "gardener.cloud:system:project-viewer"),
 		Entry("custom role", "gardener.cloud:extension:project:project-1:foo"),
 	)
+
+	It("should resolve the project name from the cache for subsequent events", func() {
+		rolebinding.Name = "gardener.cloud:system:project-member"
+
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), client.MatchingFields{"spec.namespace": ns}).DoAndReturn(func(_ context.Context, list *gardencorev1beta1.ProjectList, _ ...client.ListOption) error {
+			(&gardencorev1beta1.ProjectList{Items: []gardencorev1beta1.Project{*proj}}).DeepCopyInto(list)
+			return nil
+		})
+		c.EXPECT().Get(ctx, client.ObjectKey{Name: proj.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.Project{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *gardencorev1beta1.Project) error {
+			proj.DeepCopyInto(actual)
+			return nil
+		}).Times(2)
+
+		controller.roleBindingDelete(ctx, rolebinding)
+		controller.roleBindingDelete(ctx, rolebinding)
+
+		Expect(queue.Len()).To(Equal(1), "identical items are deduplicated by the queue")
+	})
 })
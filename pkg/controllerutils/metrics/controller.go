@@ -38,7 +38,7 @@ func NewCounterVec(name, help string) *prometheus.CounterVec {
 
 // RegisterControllerMetrics initializes the collection of Controller related metrics.
 // This function ensures to run only once for avoiding multiple controller registration.
-func RegisterControllerMetrics(metricsDesc *prometheus.Desc, scrapeFailureMetric *prometheus.CounterVec, controllers ...ControllerMetricsCollector) {
+func RegisterControllerMetrics(metricsDescs []*prometheus.Desc, scrapeFailureMetric *prometheus.CounterVec, controllers ...ControllerMetricsCollector) {
 	if metricsInitialized {
 		panic("Controller Manager metrics are already initialized")
 	}
@@ -50,7 +50,7 @@ func RegisterControllerMetrics(metricsDesc *prometheus.Desc, scrapeFailureMetric
 	// and the collectors which should collect the metrics. At the end register the collector.
 	collector = controllerCollector{
 		controllers: controllers,
-		metricDescs: []*prometheus.Desc{metricsDesc},
+		metricDescs: metricsDescs,
 	}
 	prometheus.MustRegister(collector)
 
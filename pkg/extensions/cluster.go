@@ -105,12 +105,35 @@ func SyncClusterResourceToSeed(
 	return err
 }
 
+// SyncClusterLeaseToSeed updates the lease information on the `extensions.gardener.cloud/v1alpha1.Cluster` resource
+// in the seed cluster. Besides the lease expiration it records the identity of the lease holder, so that ownership
+// checks such as the watchdog's leader check have a source of truth to compare their own identity against.
+func SyncClusterLeaseToSeed(ctx context.Context, c client.Client, clusterName string, leaseExpiration metav1.Time, holderIdentity string) error {
+	cluster := &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, cluster, func() error {
+		cluster.Spec.LeaseExpiration = &leaseExpiration
+		cluster.Spec.LeaseHolderIdentity = &holderIdentity
+		return nil
+	})
+	return err
+}
+
 // Cluster contains the decoded resources of Gardener's extension Cluster resource.
 type Cluster struct {
 	ObjectMeta   metav1.ObjectMeta
 	CloudProfile *gardencorev1beta1.CloudProfile
 	Seed         *gardencorev1beta1.Seed
 	Shoot        *gardencorev1beta1.Shoot
+	// LeaseExpiration is the time at which the gardenlet's lease for this cluster expires, if a lease is maintained.
+	LeaseExpiration *metav1.Time
+	// LeaseHolderIdentity is the identity of the gardenlet currently holding the lease for this cluster, if a lease
+	// is maintained.
+	LeaseHolderIdentity *string
 }
 
 // GetCluster tries to read Gardener's Cluster extension resource in the given namespace.
@@ -135,7 +158,7 @@ func GetCluster(ctx context.Context, c client.Client, namespace string) (*Cluste
 		return nil, err
 	}
 
-	return &Cluster{cluster.ObjectMeta, cloudProfile, seed, shoot}, nil
+	return &Cluster{cluster.ObjectMeta, cloudProfile, seed, shoot, cluster.Spec.LeaseExpiration, cluster.Spec.LeaseHolderIdentity}, nil
 }
 
 // CloudProfileFromCluster returns the CloudProfile resource inside the Cluster resource.
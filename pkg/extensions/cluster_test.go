@@ -0,0 +1,83 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensions_test
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/extensions"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("cluster", func() {
+	var (
+		ctx context.Context
+		c   client.Client
+
+		clusterName     string
+		leaseExpiration metav1.Time
+		holderIdentity  string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).NotTo(HaveOccurred())
+		c = fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		clusterName = "shoot--foo--bar"
+		leaseExpiration = metav1.NewTime(time.Now().Add(time.Minute).Round(time.Second))
+		holderIdentity = "seed-identity"
+	})
+
+	Describe("#SyncClusterLeaseToSeed", func() {
+		It("should create the cluster resource with both lease fields if it does not exist", func() {
+			Expect(SyncClusterLeaseToSeed(ctx, c, clusterName, leaseExpiration, holderIdentity)).To(Succeed())
+
+			cluster := &extensionsv1alpha1.Cluster{}
+			Expect(c.Get(ctx, kutil.Key(clusterName), cluster)).To(Succeed())
+			Expect(cluster.Spec.LeaseExpiration).To(PointTo(Equal(leaseExpiration)))
+			Expect(cluster.Spec.LeaseHolderIdentity).To(PointTo(Equal(holderIdentity)))
+		})
+
+		It("should patch both lease fields on an existing cluster resource without touching the rest of the spec", func() {
+			Expect(c.Create(ctx, &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					Shoot: runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				},
+			})).To(Succeed())
+
+			Expect(SyncClusterLeaseToSeed(ctx, c, clusterName, leaseExpiration, holderIdentity)).To(Succeed())
+
+			cluster := &extensionsv1alpha1.Cluster{}
+			Expect(c.Get(ctx, kutil.Key(clusterName), cluster)).To(Succeed())
+			Expect(cluster.Spec.LeaseExpiration).To(PointTo(Equal(leaseExpiration)))
+			Expect(cluster.Spec.LeaseHolderIdentity).To(PointTo(Equal(holderIdentity)))
+			Expect(cluster.Spec.Shoot.Raw).To(MatchJSON(`{"foo":"bar"}`))
+		})
+	})
+})
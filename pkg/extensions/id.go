@@ -16,9 +16,21 @@ package extensions
 
 import (
 	"fmt"
+	"strings"
 )
 
 // Id returns an identifier for the given extension kind/type.
 func Id(extensionKind, extensionType string) string {
 	return fmt.Sprintf("%s/%s", extensionKind, extensionType)
 }
+
+// ParseId splits an identifier built by Id back into the extension kind and type. Since extension kinds
+// never contain the separator, the identifier is split at the first occurrence, so types containing the
+// separator round-trip correctly.
+func ParseId(id string) (extensionKind, extensionType string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("extension identifier %q is not of the form <kind>/<type>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -27,4 +27,27 @@ var _ = Describe("id", func() {
 			Expect(Id("foo", "bar")).To(Equal("foo/bar"))
 		})
 	})
+
+	Describe("#ParseId", func() {
+		It("should round-trip with Id", func() {
+			kind, extensionType, err := ParseId(Id("foo", "bar"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kind).To(Equal("foo"))
+			Expect(extensionType).To(Equal("bar"))
+		})
+
+		It("should round-trip types containing the separator", func() {
+			kind, extensionType, err := ParseId(Id("foo", "bar/baz"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kind).To(Equal("foo"))
+			Expect(extensionType).To(Equal("bar/baz"))
+		})
+
+		It("should fail for malformed identifiers", func() {
+			for _, id := range []string{"", "foo", "/bar", "foo/"} {
+				_, _, err := ParseId(id)
+				Expect(err).To(HaveOccurred(), id)
+			}
+		})
+	})
 })
@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -108,6 +109,26 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 	return allErrs
 }
 
+// ValidateResourcesCapacity validates that the given resources configuration advertises a capacity for all given
+// required resource names, e.g. gardencorev1beta1.ResourceShoots. It is intentionally not part of
+// ValidateGardenletConfiguration since advertising these resources is only expected from seeds that shoots
+// should be scheduled to.
+func ValidateResourcesCapacity(resources *config.ResourcesConfiguration, fldPath *field.Path, requiredResources ...corev1.ResourceName) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, resourceName := range requiredResources {
+		if resources == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("capacity", string(resourceName)), "capacity of required resource must be specified"))
+			continue
+		}
+		if _, ok := resources.Capacity[resourceName]; !ok {
+			allErrs = append(allErrs, field.Required(fldPath.Child("capacity", string(resourceName)), "capacity of required resource must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateGardenletConfigurationUpdate validates a GardenletConfiguration object before an update.
 func ValidateGardenletConfigurationUpdate(newCfg, oldCfg *config.GardenletConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/validation"
 
@@ -325,6 +326,34 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("resources.reserved.foo"),
 				}))))
 			})
+
+			Describe("#ValidateResourcesCapacity", func() {
+				It("should forbid a missing required resource capacity", func() {
+					errorList := ValidateResourcesCapacity(cfg.Resources, field.NewPath("resources"), gardencorev1beta1.ResourceShoots)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("resources.capacity.shoots"),
+					}))))
+				})
+
+				It("should forbid missing required resource capacities if no resources are configured", func() {
+					errorList := ValidateResourcesCapacity(nil, field.NewPath("resources"), gardencorev1beta1.ResourceShoots)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("resources.capacity.shoots"),
+					}))))
+				})
+
+				It("should allow a present required resource capacity", func() {
+					cfg.Resources.Capacity[gardencorev1beta1.ResourceShoots] = resource.MustParse("250")
+
+					errorList := ValidateResourcesCapacity(cfg.Resources, field.NewPath("resources"), gardencorev1beta1.ResourceShoots)
+
+					Expect(errorList).To(BeEmpty())
+				})
+			})
 		})
 
 		Context("sni", func() {
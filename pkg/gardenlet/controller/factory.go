@@ -48,6 +48,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -168,7 +169,7 @@ func (f *GardenletControllerFactory) Run(ctx context.Context) error {
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(
-		gardenlet.ControllerWorkerSum,
+		[]*prometheus.Desc{gardenlet.ControllerWorkerSum, gardenlet.ControllerQueueLength},
 		gardenlet.ScrapeFailures,
 		backupBucketController,
 		backupEntryController,
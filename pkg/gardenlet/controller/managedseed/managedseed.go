@@ -186,4 +186,11 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	metric, err = prometheus.NewConstMetric(gardenlet.ControllerQueueLength, prometheus.GaugeValue, float64(c.managedSeedQueue.Len()), "managedSeed")
+	if err != nil {
+		gardenlet.ScrapeFailures.With(prometheus.Labels{"kind": "managedSeed-controller"}).Inc()
+		return
+	}
+	ch <- metric
 }
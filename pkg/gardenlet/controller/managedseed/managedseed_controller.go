@@ -15,15 +15,31 @@
 package managedseed
 
 import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
+// isGardenManagedSeed checks if the given object is a ManagedSeed in the garden namespace. ManagedSeeds in other
+// namespaces are not acted upon by this controller. Tombstones from the informer cache are unwrapped.
+func isGardenManagedSeed(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	managedSeed, ok := obj.(*seedmanagementv1alpha1.ManagedSeed)
+	return ok && managedSeed.Namespace == v1beta1constants.GardenNamespace
+}
+
 func (c *Controller) managedSeedAdd(obj interface{}) {
 	managedSeed, ok := obj.(*seedmanagementv1alpha1.ManagedSeed)
-	if !ok {
+	if !ok || !isGardenManagedSeed(managedSeed) {
 		return
 	}
 	key, err := cache.MetaNamespaceKeyFunc(obj)
@@ -31,25 +47,32 @@ func (c *Controller) managedSeedAdd(obj interface{}) {
 		return
 	}
 
-	if managedSeed.Generation != managedSeed.Status.ObservedGeneration || managedSeed.DeletionTimestamp != nil {
-		c.logger.Debugf("Added ManagedSeed %s without delay to the queue", key)
-		c.managedSeedQueue.Add(key)
-	} else {
-		// Spread reconciliation of managed seeds (including gardenlet updates/rollouts) across the configured sync jitter
-		// period to avoid overloading the gardener-apiserver if all gardenlets in all managed seeds are (re)starting
-		// roughly at the same time
-		duration := utils.RandomDurationWithMetaDuration(c.config.Controllers.ManagedSeed.SyncJitterPeriod)
-		c.logger.Infof("Added ManagedSeed %s with delay %s to the queue", key, duration)
-		c.managedSeedQueue.AddAfter(key, duration)
+	immediately := managedSeed.Generation != managedSeed.Status.ObservedGeneration || managedSeed.DeletionTimestamp != nil
+	duration := computeReconcileDelay(immediately, *c.config.Controllers.ManagedSeed.SyncJitterPeriod)
+	c.logger.Infof("Added ManagedSeed %s with delay %s to the queue", key, duration)
+	c.managedSeedQueue.AddAfter(key, duration)
+}
+
+// computeReconcileDelay computes the delay after which a ManagedSeed is added to the queue. ManagedSeeds that need
+// an immediate reconciliation are delayed by a short fixed duration so that bursts of events for the same object can
+// still be coalesced by the queue. All other reconciliations are spread across the configured sync jitter period
+// (including gardenlet updates/rollouts) to avoid overloading the gardener-apiserver if all gardenlets in all
+// managed seeds are (re)starting roughly at the same time.
+func computeReconcileDelay(immediately bool, jitter metav1.Duration) time.Duration {
+	if immediately {
+		return time.Second
 	}
+	return utils.RandomDurationWithMetaDuration(&jitter)
 }
 
 func (c *Controller) managedSeedUpdate(_, newObj interface{}) {
 	managedSeed, ok := newObj.(*seedmanagementv1alpha1.ManagedSeed)
-	if !ok {
+	if !ok || !isGardenManagedSeed(managedSeed) {
 		return
 	}
-	if managedSeed.Generation == managedSeed.Status.ObservedGeneration {
+	// The operation annotation allows operators to force an immediate reconciliation without bumping the generation
+	if managedSeed.Generation == managedSeed.Status.ObservedGeneration &&
+		managedSeed.Annotations[v1beta1constants.GardenerOperation] != v1beta1constants.GardenerOperationReconcile {
 		return
 	}
 	key, err := cache.MetaNamespaceKeyFunc(newObj)
@@ -60,13 +83,31 @@ func (c *Controller) managedSeedUpdate(_, newObj interface{}) {
 	c.managedSeedQueue.Add(key)
 }
 
-func (c *Controller) managedSeedDelete(obj interface{}) {
-	if _, ok := obj.(*seedmanagementv1alpha1.ManagedSeed); !ok {
-		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); !ok {
-			return
-		} else if _, ok := tombstone.Obj.(*seedmanagementv1alpha1.ManagedSeed); !ok {
-			return
+// EnqueueAll enqueues all ManagedSeeds in the garden namespace, spread across the configured sync jitter period.
+// It can be called when the gardenlet configuration changes to re-evaluate all ManagedSeeds.
+func (c *Controller) EnqueueAll(ctx context.Context) error {
+	managedSeedList := &seedmanagementv1alpha1.ManagedSeedList{}
+	if err := c.gardenClient.Client().List(ctx, managedSeedList, client.InNamespace(v1beta1constants.GardenNamespace)); err != nil {
+		return err
+	}
+
+	for i := range managedSeedList.Items {
+		key, err := cache.MetaNamespaceKeyFunc(&managedSeedList.Items[i])
+		if err != nil {
+			return err
 		}
+
+		duration := utils.RandomDurationWithMetaDuration(c.config.Controllers.ManagedSeed.SyncJitterPeriod)
+		c.logger.Infof("Added ManagedSeed %s with delay %s to the queue", key, duration)
+		c.managedSeedQueue.AddAfter(key, duration)
+	}
+
+	return nil
+}
+
+func (c *Controller) managedSeedDelete(obj interface{}) {
+	if !isGardenManagedSeed(obj) {
+		return
 	}
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
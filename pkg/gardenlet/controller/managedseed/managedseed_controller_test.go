@@ -15,10 +15,14 @@
 package managedseed
 
 import (
+	"context"
 	"time"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenerlogger "github.com/gardener/gardener/pkg/logger"
 	mockworkqueue "github.com/gardener/gardener/pkg/mock/client-go/util/workqueue"
@@ -26,8 +30,13 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const (
@@ -81,17 +90,23 @@ var _ = Describe("Controller", func() {
 			c.managedSeedAdd(&gardencorev1beta1.Seed{})
 		})
 
-		It("should add the object to the queue", func() {
-			queue.EXPECT().Add(key)
+		It("should do nothing because the object is not in the garden namespace", func() {
+			managedSeed.Namespace = "other"
+
+			c.managedSeedAdd(managedSeed)
+		})
+
+		It("should add the object to the queue with a short fixed delay", func() {
+			queue.EXPECT().AddAfter(key, time.Second)
 
 			c.managedSeedAdd(managedSeed)
 		})
 
-		It("should add the object to the queue (deletion)", func() {
+		It("should add the object to the queue with a short fixed delay (deletion)", func() {
 			now := metav1.Now()
 			managedSeed.DeletionTimestamp = &now
 			managedSeed.Status.ObservedGeneration = 1
-			queue.EXPECT().Add(key)
+			queue.EXPECT().AddAfter(key, time.Second)
 
 			c.managedSeedAdd(managedSeed)
 		})
@@ -108,11 +123,30 @@ var _ = Describe("Controller", func() {
 		})
 	})
 
+	Describe("#computeReconcileDelay", func() {
+		It("should return a short fixed delay for immediate reconciliations", func() {
+			Expect(computeReconcileDelay(true, metav1.Duration{Duration: syncJitterPeriod})).To(Equal(time.Second))
+		})
+
+		It("should return a delay within the jitter period otherwise", func() {
+			for i := 0; i < 1000; i++ {
+				d := computeReconcileDelay(false, metav1.Duration{Duration: syncJitterPeriod})
+				Expect(d >= 0 && d < syncJitterPeriod).To(BeTrue())
+			}
+		})
+	})
+
 	Describe("#managedSeedUpdate", func() {
 		It("should do nothing because the new object is not a ManagedSeed", func() {
 			c.managedSeedUpdate(nil, &gardencorev1beta1.Seed{})
 		})
 
+		It("should do nothing because the new object is not in the garden namespace", func() {
+			managedSeed.Namespace = "other"
+
+			c.managedSeedUpdate(nil, managedSeed)
+		})
+
 		It("should do nothing because the object generation and observed generation are equal", func() {
 			managedSeed.Status.ObservedGeneration = 1
 
@@ -124,6 +158,39 @@ var _ = Describe("Controller", func() {
 
 			c.managedSeedUpdate(nil, managedSeed)
 		})
+
+		It("should add the object to the queue if the operation annotation is set, even without generation change", func() {
+			managedSeed.Status.ObservedGeneration = 1
+			managedSeed.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}
+			queue.EXPECT().Add(key)
+
+			c.managedSeedUpdate(nil, managedSeed)
+		})
+	})
+
+	Describe("#EnqueueAll", func() {
+		It("should enqueue all ManagedSeeds in the garden namespace with a jittered delay", func() {
+			s := runtime.NewScheme()
+			Expect(seedmanagementv1alpha1.AddToScheme(s)).To(Succeed())
+
+			gardenClient := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(
+				&seedmanagementv1alpha1.ManagedSeed{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace}},
+				&seedmanagementv1alpha1.ManagedSeed{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace}},
+				&seedmanagementv1alpha1.ManagedSeed{ObjectMeta: metav1.ObjectMeta{Name: "baz", Namespace: "other"}},
+			).Build()
+			c.gardenClient = fakekubernetes.NewClientSetBuilder().WithClient(gardenClient).Build()
+
+			var enqueuedKeys []interface{}
+			queue.EXPECT().AddAfter(gomock.Any(), gomock.AssignableToTypeOf(time.Second)).DoAndReturn(
+				func(key interface{}, d time.Duration) {
+					enqueuedKeys = append(enqueuedKeys, key)
+					Expect(d > 0 && d <= syncJitterPeriod).To(BeTrue())
+				},
+			).Times(2)
+
+			Expect(c.EnqueueAll(context.TODO())).To(Succeed())
+			Expect(enqueuedKeys).To(ConsistOf(namespace+"/foo", namespace+"/bar"))
+		})
 	})
 
 	Describe("#managedSeedDelete", func() {
@@ -135,6 +202,18 @@ var _ = Describe("Controller", func() {
 			c.managedSeedDelete(cache.DeletedFinalStateUnknown{Key: key, Obj: &gardencorev1beta1.Seed{}})
 		})
 
+		It("should do nothing because the object is not in the garden namespace", func() {
+			managedSeed.Namespace = "other"
+
+			c.managedSeedDelete(managedSeed)
+		})
+
+		It("should do nothing because the object is a tombstone of a ManagedSeed in another namespace", func() {
+			managedSeed.Namespace = "other"
+
+			c.managedSeedDelete(cache.DeletedFinalStateUnknown{Key: key, Obj: managedSeed})
+		})
+
 		It("should add the object to the queue", func() {
 			queue.EXPECT().Add(key)
 
@@ -147,4 +226,39 @@ var _ = Describe("Controller", func() {
 			c.managedSeedDelete(cache.DeletedFinalStateUnknown{Key: key, Obj: managedSeed})
 		})
 	})
+
+	Describe("#CollectMetrics", func() {
+		collectQueueLength := func() float64 {
+			ch := make(chan prometheus.Metric, 2)
+			c.CollectMetrics(ch)
+			close(ch)
+
+			for metric := range ch {
+				if metric.Desc() == gardenlet.ControllerQueueLength {
+					var m dto.Metric
+					Expect(metric.Write(&m)).To(Succeed())
+					return m.GetGauge().GetValue()
+				}
+			}
+
+			Fail("no queue length metric has been collected")
+			return 0
+		}
+
+		It("should expose the queue length, reflecting enqueued and processed items", func() {
+			managedSeedQueue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ManagedSeed")
+			defer managedSeedQueue.ShutDown()
+			c.managedSeedQueue = managedSeedQueue
+
+			Expect(collectQueueLength()).To(BeZero())
+
+			managedSeedQueue.Add(namespace + "/foo")
+			managedSeedQueue.Add(namespace + "/bar")
+			Expect(collectQueueLength()).To(Equal(float64(2)))
+
+			item, _ := managedSeedQueue.Get()
+			managedSeedQueue.Done(item)
+			Expect(collectQueueLength()).To(Equal(float64(1)))
+		})
+	})
 })
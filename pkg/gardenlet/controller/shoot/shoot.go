@@ -36,6 +36,7 @@ import (
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -253,10 +254,18 @@ func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterf
 }
 
 func (c *Controller) newProgressReporter(reporterFn flow.ProgressReporterFn) flow.ProgressReporter {
+	var reporter flow.ProgressReporter = flow.NewImmediateProgressReporter(reporterFn)
 	if c.config.Controllers.Shoot != nil && c.config.Controllers.Shoot.ProgressReportPeriod != nil {
-		return flow.NewDelayingProgressReporter(reporterFn, c.config.Controllers.Shoot.ProgressReportPeriod.Duration)
+		reporter = flow.NewDelayingProgressReporter(reporterFn, c.config.Controllers.Shoot.ProgressReportPeriod.Duration)
 	}
-	return flow.NewImmediateProgressReporter(reporterFn)
+
+	// With debug logging enabled, additionally record per-task durations and log a summary when the flow has
+	// finished, which helps analyzing slow reconciliations and control plane migrations.
+	if logger.Logger.IsLevelEnabled(logrus.DebugLevel) {
+		reporter = flow.NewTimingProgressReporter(reporter, logger.Logger)
+	}
+
+	return reporter
 }
 
 func (c *Controller) shootIsSeed(ctx context.Context, shoot *gardencorev1beta1.Shoot) bool {
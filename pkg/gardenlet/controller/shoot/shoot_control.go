@@ -111,6 +111,10 @@ func confineSpecUpdateRollout(maintenance *gardencorev1beta1.Maintenance) bool {
 	return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout
 }
 
+// clusterLeaseDuration is the duration for which the gardenlet's lease on a Cluster resource is valid before
+// it has to be renewed by the next Cluster sync.
+const clusterLeaseDuration = 2 * time.Minute
+
 func (c *Controller) syncClusterResourceToSeed(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, cloudProfile *gardencorev1beta1.CloudProfile, seed *gardencorev1beta1.Seed) error {
 	clusterName := shootpkg.ComputeTechnicalID(project.Name, shoot)
 
@@ -119,7 +123,13 @@ func (c *Controller) syncClusterResourceToSeed(ctx context.Context, shoot *garde
 		return fmt.Errorf("could not initialize a new Kubernetes client for the seed cluster: %+v", err)
 	}
 
-	return gardenerextensions.SyncClusterResourceToSeed(ctx, seedClient.Client(), clusterName, shoot, cloudProfile, seed)
+	if err := gardenerextensions.SyncClusterResourceToSeed(ctx, seedClient.Client(), clusterName, shoot, cloudProfile, seed); err != nil {
+		return err
+	}
+
+	// Renew the gardenlet's lease on the Cluster resource together with the Cluster sync, so that extension
+	// controllers watching the lease can detect when this gardenlet stops managing the cluster.
+	return gardenerextensions.SyncClusterLeaseToSeed(ctx, seedClient.Client(), clusterName, metav1.NewTime(time.Now().Add(clusterLeaseDuration)), c.identity.ID)
 }
 
 func (c *Controller) checkSeedAndSyncClusterResource(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, cloudProfile *gardencorev1beta1.CloudProfile, seed *gardencorev1beta1.Seed) error {
@@ -576,7 +586,7 @@ func (c *Controller) updateShootStatusOperationStart(ctx context.Context, garden
 
 	case gardencorev1beta1.LastOperationTypeMigrate:
 		description = "Migration of Shoot cluster control plane initialized."
-		operationTypeSwitched = false
+		operationTypeSwitched = !gutil.IsShootInMigration(shoot)
 
 	case gardencorev1beta1.LastOperationTypeDelete:
 		description = "Deletion of Shoot cluster in progress."
@@ -34,7 +34,6 @@ import (
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -53,6 +52,7 @@ func (c *Controller) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		controlPlaneDeploymentNeeded         bool
 		tasksWithErrors                      []string
 		err                                  error
+		seedNamespaceState                   botanistpkg.SeedNamespaceState
 	)
 
 	for _, lastError := range o.Shoot.Info.Status.LastErrors {
@@ -84,15 +84,15 @@ func (c *Controller) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		// We first check whether the namespace in the Seed cluster does exist - if it does not, then we assume that
 		// all resources have already been deleted. We can delete the Shoot resource as a consequence.
 		errors.ToExecute("Retrieve the Shoot namespace in the Seed cluster", func() error {
-			botanist.SeedNamespaceObject = &corev1.Namespace{}
-			err := botanist.K8sSeedClient.APIReader().Get(ctx, client.ObjectKey{Name: o.Shoot.SeedNamespace}, botanist.SeedNamespaceObject)
+			seedNamespaceState, err = botanist.FetchSeedNamespaceObject(ctx)
 			if err != nil {
-				if apierrors.IsNotFound(err) {
-					o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - nothing to be done", o.Shoot.SeedNamespace)
-					return errors.Cancel()
-				}
+				return err
 			}
-			return err
+			if seedNamespaceState == botanistpkg.SeedNamespaceStateNotFound {
+				o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - nothing to be done", o.Shoot.SeedNamespace)
+				return errors.Cancel()
+			}
+			return nil
 		}),
 		// We check whether the kube-apiserver deployment exists in the shoot namespace. If it does not, then we assume
 		// that it has never been deployed successfully, or that we have deleted it in a previous run because we already
@@ -154,7 +154,7 @@ func (c *Controller) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 	}
 
 	var (
-		nonTerminatingNamespace = botanist.SeedNamespaceObject.Status.Phase != corev1.NamespaceTerminating
+		nonTerminatingNamespace = seedNamespaceState == botanistpkg.SeedNamespaceStateActive
 		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerDeploymentFound && infrastructure != nil
 		defaultInterval         = 5 * time.Second
 		defaultTimeout          = 30 * time.Second
@@ -56,6 +56,13 @@ func (c *Controller) prepareShootForMigration(ctx context.Context, logger *logru
 		return reconcile.Result{}, fmt.Errorf("shoot %s is failed or ignored, will skip migration preparation", shoot.GetName())
 	}
 
+	// If the shoot was already successfully prepared for migration it waits for the restoration on the new seed,
+	// so there is nothing left to be done here.
+	if gutil.IsShootRestorePending(shoot) {
+		logger.Infof("Shoot %s is already prepared for migration, will skip migration preparation", shoot.GetName())
+		return reconcile.Result{}, nil
+	}
+
 	c.recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventPrepareMigration, "Prepare Shoot cluster for migration")
 	shootNamespace := shootpkg.ComputeTechnicalID(project.Name, shoot)
 	if err = c.updateShootStatusOperationStart(ctx, gardenClient.Client(), shoot, shootNamespace, gardencorev1beta1.LastOperationTypeMigrate); err != nil {
@@ -84,6 +91,7 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 		err                          error
 		tasksWithErrors              []string
 		kubeAPIServerDeploymentFound = true
+		seedNamespaceState           botanistpkg.SeedNamespaceState
 	)
 
 	for _, lastError := range o.Shoot.Info.Status.LastErrors {
@@ -123,15 +131,15 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 			return nil
 		}),
 		utilerrors.ToExecute("Retrieve the Shoot namespace in the Seed cluster", func() error {
-			botanist.SeedNamespaceObject = &corev1.Namespace{}
-			err := botanist.K8sSeedClient.APIReader().Get(ctx, client.ObjectKey{Name: o.Shoot.SeedNamespace}, botanist.SeedNamespaceObject)
+			seedNamespaceState, err = botanist.FetchSeedNamespaceObject(ctx)
 			if err != nil {
-				if apierrors.IsNotFound(err) {
-					o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - nothing to be done", o.Shoot.SeedNamespace)
-					return utilerrors.Cancel()
-				}
+				return err
 			}
-			return err
+			if seedNamespaceState == botanistpkg.SeedNamespaceStateNotFound {
+				o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - nothing to be done", o.Shoot.SeedNamespace)
+				return utilerrors.Cancel()
+			}
+			return nil
 		}),
 	)
 
@@ -143,7 +151,7 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 	}
 
 	var (
-		nonTerminatingNamespace = botanist.SeedNamespaceObject.Status.Phase != corev1.NamespaceTerminating
+		nonTerminatingNamespace = seedNamespaceState == botanistpkg.SeedNamespaceStateActive
 		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerDeploymentFound
 		wakeupRequired          = (o.Shoot.Info.Status.IsHibernated || (!o.Shoot.Info.Status.IsHibernated && o.Shoot.HibernationEnabled)) && cleanupShootResources
 		defaultTimeout          = 10 * time.Minute
@@ -242,7 +250,7 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 		})
 		migrateInternalDNSRecord = g.Add(flow.Task{
 			Name:         "Migrating internal domain DNS record",
-			Fn:           flow.TaskFn(botanist.MigrateInternalDNSResources),
+			Fn:           flow.TaskFn(botanist.MigrateInternalDNSResources).DoIf(shootpkg.InternalDNSRequired(o.Seed.Info, o.Shoot.Info)),
 			Dependencies: flow.NewTaskIDs(waitUntilAPIServerDeleted),
 		})
 		destroyDNSProviders = g.Add(flow.Task{
@@ -255,10 +263,15 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 			Fn:           flow.TaskFn(botanist.SnapshotEtcd).DoIf(nonTerminatingNamespace),
 			Dependencies: flow.NewTaskIDs(waitUntilAPIServerDeleted),
 		})
+		waitUntilETCDSnapshotVerified = g.Add(flow.Task{
+			Name:         "Waiting until the ETCD snapshot is verified",
+			Fn:           flow.TaskFn(botanist.WaitUntilEtcdSnapshotVerified).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(nonTerminatingNamespace),
+			Dependencies: flow.NewTaskIDs(createETCDSnapshot),
+		})
 		scaleETCDToZero = g.Add(flow.Task{
 			Name:         "Scaling ETCD to zero",
 			Fn:           flow.TaskFn(botanist.ScaleETCDToZero).DoIf(nonTerminatingNamespace),
-			Dependencies: flow.NewTaskIDs(createETCDSnapshot),
+			Dependencies: flow.NewTaskIDs(waitUntilETCDSnapshotVerified),
 		})
 		migrateBackupEntryInGarden = g.Add(flow.Task{
 			Name:         "Migrate BackupEntry to new seed",
@@ -270,10 +283,15 @@ func (c *Controller) runPrepareShootControlPlaneMigration(o *operation.Operation
 			Fn:           flow.TaskFn(botanist.Shoot.Components.BackupEntry.WaitMigrate),
 			Dependencies: flow.NewTaskIDs(migrateBackupEntryInGarden),
 		})
+		verifyNoStaleExtensionFinalizers = g.Add(flow.Task{
+			Name:         "Verifying that no extension resources with stale finalizers are left in the Shoot namespace",
+			Fn:           botanist.EnsureNoStaleExtensionFinalizers,
+			Dependencies: flow.NewTaskIDs(waitUntilBackupEntryInGardenMigrated, deleteAllExtensionCRs, destroyDNSProviders, waitForManagedResourcesDeletion, waitUntilETCDSnapshotVerified, scaleETCDToZero),
+		})
 		deleteNamespace = g.Add(flow.Task{
 			Name:         "Deleting shoot namespace in Seed",
 			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(waitUntilBackupEntryInGardenMigrated, deleteAllExtensionCRs, destroyDNSProviders, waitForManagedResourcesDeletion, scaleETCDToZero),
+			Dependencies: flow.NewTaskIDs(verifyNoStaleExtensionFinalizers),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespace in Seed has been deleted",
@@ -22,6 +22,9 @@ var (
 	// ControllerWorkerSum is a metric descriptor which collects the current amount of workers per controller.
 	ControllerWorkerSum = gardenmetrics.NewMetricDescriptor("gardenlet_worker_amount", "Count of currently running controller workers")
 
+	// ControllerQueueLength is a metric descriptor which collects the current length of the workqueue per controller.
+	ControllerQueueLength = gardenmetrics.NewMetricDescriptor("gardenlet_queue_length", "Count of items currently queued in a controller workqueue")
+
 	// ScrapeFailures is a metric descriptor which counts the amount scrape issues grouped by kind.
 	ScrapeFailures = gardenmetrics.NewCounterVec("gardenlet_scrape_failure_total", "Total count of scraping failures, grouped by kind/group of metric(s)")
 )
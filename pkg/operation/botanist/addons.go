@@ -206,7 +206,7 @@ func (b *Botanist) SetNginxIngressAddress(address string, seedClient client.Clie
 			b.Shoot.SeedNamespace,
 			&dns.OwnerValues{
 				Name:    common.ShootDNSIngressName,
-				Active:  pointer.Bool(true),
+				Active:  pointer.Bool(!b.Shoot.HibernationEnabled),
 				OwnerID: ownerID,
 			},
 		)
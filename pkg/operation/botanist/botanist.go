@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/botanist/component/etcd"
 	"github.com/gardener/gardener/pkg/operation/botanist/component/logging"
+	gardenpkg "github.com/gardener/gardener/pkg/operation/garden"
 	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -179,8 +180,13 @@ func (b *Botanist) RequiredExtensionsReady(ctx context.Context) error {
 		return err
 	}
 
+	var defaultDomain *gardenpkg.Domain
+	if b.Shoot.Info.Spec.DNS != nil && b.Shoot.Info.Spec.DNS.Domain != nil {
+		defaultDomain = gardenpkg.DomainIsDefaultDomain(*b.Shoot.Info.Spec.DNS.Domain, b.Garden.DefaultDomains)
+	}
+
 	requiredExtensions := shootpkg.ComputeRequiredExtensions(b.Shoot.Info, b.Seed.Info, controllerRegistrationList, b.Garden.InternalDomain, b.Shoot.ExternalDomain,
-		gardenletfeatures.FeatureGate.Enabled(features.UseDNSRecords))
+		defaultDomain, gardenletfeatures.FeatureGate.Enabled(features.UseDNSRecords))
 
 	for _, controllerInstallation := range controllerInstallationList.Items {
 		if controllerInstallation.Spec.SeedRef.Name != b.Seed.Info.Name {
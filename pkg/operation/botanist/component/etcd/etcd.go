@@ -16,7 +16,9 @@ package etcd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
@@ -102,6 +104,8 @@ type Interface interface {
 	ServiceDNSNames() []string
 	// Snapshot triggers the backup-restore sidecar to perform a full snapshot in case backup configuration is provided.
 	Snapshot(context.Context, kubernetes.PodExecutor) error
+	// VerifyFullSnapshot checks via the backup-restore sidecar that the latest snapshot is a full snapshot.
+	VerifyFullSnapshot(context.Context, kubernetes.PodExecutor) error
 	// SetSecrets sets the secrets.
 	SetSecrets(Secrets)
 	// SetBackupConfig sets the backup configuration.
@@ -556,27 +560,75 @@ func (e *etcd) Snapshot(ctx context.Context, podExecutor kubernetes.PodExecutor)
 		return fmt.Errorf("no backup is configured for this etcd, cannot make a snapshot")
 	}
 
+	podName, err := e.getBackupRestorePodName(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = podExecutor.Execute(
+		e.namespace,
+		podName,
+		containerNameBackupRestore,
+		"/bin/sh",
+		fmt.Sprintf("curl -k https://etcd-%s-local:%d/snapshot/full", e.role, PortBackupRestore),
+	)
+	return err
+}
+
+func (e *etcd) VerifyFullSnapshot(ctx context.Context, podExecutor kubernetes.PodExecutor) error {
+	if e.backupConfig == nil {
+		return fmt.Errorf("no backup is configured for this etcd, cannot verify a snapshot")
+	}
+
+	podName, err := e.getBackupRestorePodName(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, err := podExecutor.Execute(
+		e.namespace,
+		podName,
+		containerNameBackupRestore,
+		"/bin/sh",
+		fmt.Sprintf("curl -k https://etcd-%s-local:%d/snapshot/latest", e.role, PortBackupRestore),
+	)
+	if err != nil {
+		return err
+	}
+
+	response, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	latestSnapshot := struct {
+		Kind string `json:"kind"`
+	}{}
+	if err := json.Unmarshal(response, &latestSnapshot); err != nil {
+		return fmt.Errorf("could not parse response of backup-restore sidecar: %w", err)
+	}
+
+	if latestSnapshot.Kind != "Full" {
+		return fmt.Errorf("latest snapshot is not a full snapshot (kind %q)", latestSnapshot.Kind)
+	}
+	return nil
+}
+
+func (e *etcd) getBackupRestorePodName(ctx context.Context) (string, error) {
 	etcdMainSelector := e.podLabelSelector()
 
 	podsList := &corev1.PodList{}
 	if err := e.client.List(ctx, podsList, client.InNamespace(e.namespace), client.MatchingLabelsSelector{Selector: etcdMainSelector}); err != nil {
-		return err
+		return "", err
 	}
 	if len(podsList.Items) == 0 {
-		return fmt.Errorf("didn't find any pods for selector: %v", etcdMainSelector)
+		return "", fmt.Errorf("didn't find any pods for selector: %v", etcdMainSelector)
 	}
 	if len(podsList.Items) > 1 {
-		return fmt.Errorf("multiple ETCD Pods found. Pod list found: %v", podsList.Items)
+		return "", fmt.Errorf("multiple ETCD Pods found. Pod list found: %v", podsList.Items)
 	}
 
-	_, err := podExecutor.Execute(
-		e.namespace,
-		podsList.Items[0].GetName(),
-		containerNameBackupRestore,
-		"/bin/sh",
-		fmt.Sprintf("curl -k https://etcd-%s-local:%d/snapshot/full", e.role, PortBackupRestore),
-	)
-	return err
+	return podsList.Items[0].GetName(), nil
 }
 
 func (e *etcd) ServiceDNSNames() []string {
@@ -17,6 +17,7 @@ package etcd_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -1175,4 +1176,97 @@ var _ = Describe("Etcd", func() {
 			})
 		})
 	})
+
+	Describe("#VerifyFullSnapshot", func() {
+		It("should return an error when the backup config is nil", func() {
+			Expect(etcd.VerifyFullSnapshot(ctx, nil)).To(MatchError(ContainSubstring("no backup is configured")))
+		})
+
+		Context("w/ backup configuration", func() {
+			var (
+				podExecutor *mockkubernetes.MockPodExecutor
+				podName     = "some-etcd-pod"
+				selector    = labels.SelectorFromSet(map[string]string{
+					"app":  "etcd-statefulset",
+					"role": testRole,
+				})
+				podList *corev1.PodList
+			)
+
+			BeforeEach(func() {
+				etcd.SetBackupConfig(&BackupConfig{})
+				podExecutor = mockkubernetes.NewMockPodExecutor(ctrl)
+
+				podList = &corev1.PodList{
+					Items: []corev1.Pod{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: podName,
+							},
+						},
+					},
+				}
+
+				c.EXPECT().List(
+					ctx,
+					gomock.AssignableToTypeOf(&corev1.PodList{}),
+					client.InNamespace(testNamespace),
+					client.MatchingLabelsSelector{Selector: selector},
+				).DoAndReturn(
+					func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						podList.DeepCopyInto(list.(*corev1.PodList))
+						return nil
+					},
+				)
+			})
+
+			It("should succeed when the latest snapshot is a full snapshot", func() {
+				podExecutor.EXPECT().Execute(
+					testNamespace,
+					podName,
+					"backup-restore",
+					"/bin/sh",
+					"curl -k https://etcd-"+testRole+"-local:8080/snapshot/latest",
+				).Return(strings.NewReader(`{"kind":"Full","snapName":"some-snapshot"}`), nil)
+
+				Expect(etcd.VerifyFullSnapshot(ctx, podExecutor)).To(Succeed())
+			})
+
+			It("should return an error when the latest snapshot is not a full snapshot", func() {
+				podExecutor.EXPECT().Execute(
+					testNamespace,
+					podName,
+					"backup-restore",
+					"/bin/sh",
+					"curl -k https://etcd-"+testRole+"-local:8080/snapshot/latest",
+				).Return(strings.NewReader(`{"kind":"Incr","snapName":"some-snapshot"}`), nil)
+
+				Expect(etcd.VerifyFullSnapshot(ctx, podExecutor)).To(MatchError(ContainSubstring("not a full snapshot")))
+			})
+
+			It("should return an error when the response cannot be parsed", func() {
+				podExecutor.EXPECT().Execute(
+					testNamespace,
+					podName,
+					"backup-restore",
+					"/bin/sh",
+					"curl -k https://etcd-"+testRole+"-local:8080/snapshot/latest",
+				).Return(strings.NewReader(`not-json`), nil)
+
+				Expect(etcd.VerifyFullSnapshot(ctx, podExecutor)).To(MatchError(ContainSubstring("could not parse response")))
+			})
+
+			It("should return an error when the execution command fails", func() {
+				podExecutor.EXPECT().Execute(
+					testNamespace,
+					podName,
+					"backup-restore",
+					"/bin/sh",
+					"curl -k https://etcd-"+testRole+"-local:8080/snapshot/latest",
+				).Return(nil, fakeErr)
+
+				Expect(etcd.VerifyFullSnapshot(ctx, podExecutor)).To(MatchError(fakeErr))
+			})
+		})
+	})
 })
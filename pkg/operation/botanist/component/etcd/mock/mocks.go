@@ -158,6 +158,20 @@ func (mr *MockInterfaceMockRecorder) Snapshot(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockInterface)(nil).Snapshot), arg0, arg1)
 }
 
+// VerifyFullSnapshot mocks base method.
+func (m *MockInterface) VerifyFullSnapshot(arg0 context.Context, arg1 kubernetes.PodExecutor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyFullSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyFullSnapshot indicates an expected call of VerifyFullSnapshot.
+func (mr *MockInterfaceMockRecorder) VerifyFullSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyFullSnapshot", reflect.TypeOf((*MockInterface)(nil).VerifyFullSnapshot), arg0, arg1)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -58,17 +58,30 @@ type owner struct {
 	dnsOwner *dnsv1alpha1.DNSOwner
 }
 
-func (o *owner) Deploy(ctx context.Context) error {
-	active := o.values.Active
+// BuildOwner returns the DNSOwner object that Deploy would apply for the given namespace and values, without
+// deploying it. This allows rendering the object for preview or diffing purposes.
+func BuildOwner(namespace string, values *OwnerValues) *dnsv1alpha1.DNSOwner {
+	active := values.Active
 	if active == nil {
 		active = pointer.Bool(true)
 	}
 
-	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, o.client, o.dnsOwner, func() error {
-		o.dnsOwner.Spec = dnsv1alpha1.DNSOwnerSpec{
-			OwnerId: o.values.OwnerID,
+	return &dnsv1alpha1.DNSOwner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace + "-" + values.Name,
+		},
+		Spec: dnsv1alpha1.DNSOwnerSpec{
+			OwnerId: values.OwnerID,
 			Active:  active,
-		}
+		},
+	}
+}
+
+func (o *owner) Deploy(ctx context.Context) error {
+	desired := BuildOwner(o.namespace, o.values)
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, o.client, o.dnsOwner, func() error {
+		o.dnsOwner.Spec = desired.Spec
 		return nil
 	})
 	return err
@@ -108,6 +108,40 @@ var _ = Describe("#DNSOwner", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(actualDNSOwner).To(DeepDerivativeEqual(expectedDNSOwner))
 		})
+
+		It("should deactivate an existing DNSOwner when redeployed as inactive", func() {
+			Expect(defaultDepWaiter.Deploy(ctx)).ToNot(HaveOccurred())
+
+			vals.Active = pointer.Bool(false)
+			defaultDepWaiter = NewOwner(c, deployNS, vals)
+
+			Expect(defaultDepWaiter.Deploy(ctx)).ToNot(HaveOccurred())
+
+			actualDNSOwner := &dnsv1alpha1.DNSOwner{}
+			err := c.Get(ctx, client.ObjectKey{Name: deployNS + "-" + dnsOwnerName}, actualDNSOwner)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualDNSOwner.Spec.Active).To(Equal(pointer.Bool(false)))
+		})
+	})
+
+	Describe("#BuildOwner", func() {
+		It("should build the expected DNSOwner for active true", func() {
+			Expect(BuildOwner(deployNS, vals)).To(Equal(expectedDNSOwner))
+		})
+
+		It("should build the expected DNSOwner for active false", func() {
+			vals.Active = pointer.Bool(false)
+			expectedDNSOwner.Spec.Active = pointer.Bool(false)
+
+			Expect(BuildOwner(deployNS, vals)).To(Equal(expectedDNSOwner))
+		})
+
+		It("should default active to true if not set", func() {
+			vals.Active = nil
+
+			Expect(BuildOwner(deployNS, vals)).To(Equal(expectedDNSOwner))
+		})
 	})
 
 	Describe("#Destroy", func() {
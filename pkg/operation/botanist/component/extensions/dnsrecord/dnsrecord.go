@@ -26,6 +26,7 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/operation/botanist/component"
@@ -150,7 +151,7 @@ func (c *dnsRecord) deploy(ctx context.Context, operation string) (extensionsv1a
 			Zone:       c.values.Zone,
 			Name:       c.values.DNSName,
 			RecordType: c.values.RecordType,
-			Values:     c.values.Values,
+			Values:     extensionsv1alpha1helper.NormalizeDNSRecordValues(c.values.RecordType, c.values.Values),
 			TTL:        c.values.TTL,
 		}
 
@@ -841,7 +841,7 @@ func (b *Botanist) setAPIServerAddress(address string, seedClient client.Client)
 			b.Shoot.SeedNamespace,
 			&dns.OwnerValues{
 				Name:    DNSInternalName,
-				Active:  pointer.Bool(true),
+				Active:  pointer.Bool(!b.Shoot.HibernationEnabled),
 				OwnerID: ownerID,
 			},
 		)
@@ -869,7 +869,7 @@ func (b *Botanist) setAPIServerAddress(address string, seedClient client.Client)
 			b.Shoot.SeedNamespace,
 			&dns.OwnerValues{
 				Name:    DNSExternalName,
-				Active:  pointer.Bool(true),
+				Active:  pointer.Bool(!b.Shoot.HibernationEnabled),
 				OwnerID: ownerID,
 			},
 		)
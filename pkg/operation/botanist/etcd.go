@@ -131,6 +131,12 @@ func (b *Botanist) SnapshotEtcd(ctx context.Context) error {
 	return b.Shoot.Components.ControlPlane.EtcdMain.Snapshot(ctx, kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig()))
 }
 
+// WaitUntilEtcdSnapshotVerified executes into the etcd-main pod and verifies that the latest snapshot is a full
+// snapshot, i.e. that the snapshot triggered by SnapshotEtcd actually completed.
+func (b *Botanist) WaitUntilEtcdSnapshotVerified(ctx context.Context) error {
+	return b.Shoot.Components.ControlPlane.EtcdMain.VerifyFullSnapshot(ctx, kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig()))
+}
+
 // ScaleETCDToZero scales ETCD main and events replicas to zero.
 func (b *Botanist) ScaleETCDToZero(ctx context.Context) error {
 	return b.scaleETCD(ctx, 0)
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DefaultExtension creates the default deployer for the Extension custom resources.
@@ -33,7 +34,7 @@ func (b *Botanist) DefaultExtension(ctx context.Context) (extension.Interface, e
 		return nil, err
 	}
 
-	extensions, err := mergeExtensions(controllerRegistrations.Items, b.Shoot.Info.Spec.Extensions, b.Shoot.SeedNamespace)
+	extensions, _, err := MergeExtensions(controllerRegistrations.Items, b.Shoot.Info.Spec.Extensions, b.Shoot.SeedNamespace)
 	if err != nil {
 		return nil, fmt.Errorf("cannot calculate required extensions for shoot %s: %v", b.Shoot.Info.Name, err)
 	}
@@ -60,15 +61,22 @@ func (b *Botanist) DeployExtensions(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.Extension.Deploy(ctx)
 }
 
-func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, namespace string) (map[string]extension.Extension, error) {
+// MergeExtensions computes the extensions that are required for the given shoot from the registered extensions and
+// the extensions configured in the shoot spec. Besides the map of required extensions it returns the set of extension
+// types that are registered but explicitly disabled in the shoot spec, so that callers can actively destroy their
+// custom resources instead of just not creating them.
+func MergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, namespace string) (map[string]extension.Extension, sets.String, error) {
 	var (
 		typeToExtension    = make(map[string]extension.Extension)
 		requiredExtensions = make(map[string]extension.Extension)
+		disabledExtensions = sets.NewString()
 	)
 
 	// Extensions enabled by default for all Shoot clusters.
 	for _, reg := range registrations {
 		for _, res := range reg.Spec.Resources {
+			// Only Extension resources contribute to the merge. Registrations may also declare other kinds
+			// (e.g. Network), which are handled by their dedicated controllers.
 			if res.Kind != extensionsv1alpha1.ExtensionResource {
 				continue
 			}
@@ -104,14 +112,18 @@ func mergeExtensions(registrations []gardencorev1beta1.ControllerRegistration, e
 		if obj, ok := typeToExtension[extension.Type]; ok {
 			if utils.IsTrue(extension.Disabled) {
 				delete(requiredExtensions, extension.Type)
+				disabledExtensions.Insert(extension.Type)
 				continue
 			}
 
 			obj.Spec.ProviderConfig = extension.ProviderConfig
+			if extension.Timeout != nil {
+				obj.Timeout = extension.Timeout.Duration
+			}
 			requiredExtensions[extension.Type] = obj
 			continue
 		}
 	}
 
-	return requiredExtensions, nil
+	return requiredExtensions, disabledExtensions, nil
 }
@@ -39,6 +39,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -280,6 +281,113 @@ var _ = Describe("Extensions", func() {
 		)
 	})
 
+	Describe("#MergeExtensions", func() {
+		var (
+			fooRegistration = gardencorev1beta1.ControllerRegistration{
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{
+						{
+							Kind: extensionsv1alpha1.ExtensionResource,
+							Type: "foo",
+						},
+					},
+				},
+			}
+			barRegistration = gardencorev1beta1.ControllerRegistration{
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{
+						{
+							Kind:            extensionsv1alpha1.ExtensionResource,
+							Type:            "bar",
+							GloballyEnabled: pointer.Bool(true),
+						},
+					},
+				},
+			}
+		)
+
+		DescribeTable("#MergeExtensions",
+			func(registrations []gardencorev1beta1.ControllerRegistration, extensions []gardencorev1beta1.Extension, extensionsMatcher, disabledMatcher gomegatypes.GomegaMatcher) {
+				mergedExtensions, disabledExtensions, err := MergeExtensions(registrations, extensions, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mergedExtensions).To(extensionsMatcher)
+				Expect(disabledExtensions).To(disabledMatcher)
+			},
+
+			Entry(
+				"No extensions",
+				nil,
+				nil,
+				BeEmpty(),
+				BeEmpty(),
+			),
+			Entry(
+				"Globally enabled extension registration but explicitly disabled",
+				[]gardencorev1beta1.ControllerRegistration{barRegistration},
+				[]gardencorev1beta1.Extension{{Type: "bar", Disabled: pointer.Bool(true)}},
+				BeEmpty(),
+				Equal(sets.NewString("bar")),
+			),
+			Entry(
+				"Multiple registrations but a globally enabled one is explicitly disabled",
+				[]gardencorev1beta1.ControllerRegistration{fooRegistration, barRegistration},
+				[]gardencorev1beta1.Extension{{Type: "foo"}, {Type: "bar", Disabled: pointer.Bool(true)}},
+				SatisfyAll(HaveLen(1), HaveKey("foo")),
+				Equal(sets.NewString("bar")),
+			),
+			Entry(
+				"Disabled extension w/o registration",
+				nil,
+				[]gardencorev1beta1.Extension{{Type: "foo", Disabled: pointer.Bool(true)}},
+				BeEmpty(),
+				BeEmpty(),
+			),
+			Entry(
+				"Registration declaring a non-Extension kind",
+				[]gardencorev1beta1.ControllerRegistration{
+					{
+						Spec: gardencorev1beta1.ControllerRegistrationSpec{
+							Resources: []gardencorev1beta1.ControllerResource{
+								{
+									Kind:            extensionsv1alpha1.NetworkResource,
+									Type:            "foo",
+									GloballyEnabled: pointer.Bool(true),
+								},
+							},
+						},
+					},
+				},
+				nil,
+				BeEmpty(),
+				BeEmpty(),
+			),
+			Entry(
+				"Extension with timeout overriding the registration's reconcile timeout",
+				[]gardencorev1beta1.ControllerRegistration{
+					{
+						Spec: gardencorev1beta1.ControllerRegistrationSpec{
+							Resources: []gardencorev1beta1.ControllerResource{
+								{
+									Kind:             extensionsv1alpha1.ExtensionResource,
+									Type:             "foo",
+									ReconcileTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+								},
+							},
+						},
+					},
+				},
+				[]gardencorev1beta1.Extension{{Type: "foo", Timeout: &metav1.Duration{Duration: 5 * time.Minute}}},
+				HaveKeyWithValue(
+					Equal("foo"),
+					MatchFields(IgnoreExtras, Fields{
+						"Timeout": Equal(5 * time.Minute),
+					}),
+				),
+				BeEmpty(),
+			),
+		)
+	})
+
 	Describe("#DeployExtensions", func() {
 		Context("deploy", func() {
 			It("should deploy successfully", func() {
@@ -16,12 +16,24 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/botanist/component"
 	"github.com/gardener/gardener/pkg/utils/flow"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ExtensionResourceMigrationConcurrency is the maximum number of extension components whose resources are
+// migrated, waited for, or destroyed concurrently during control plane migration. It can be tuned for shoots
+// with many extension resources; values <= 0 disable the cap.
+var ExtensionResourceMigrationConcurrency = 4
+
 // MigrateAllExtensionResources migrates all extension CRs.
 func (b *Botanist) MigrateAllExtensionResources(ctx context.Context) (err error) {
 	return b.runParallelTaskForEachExtensionComponent(ctx, func(c component.DeployMigrateWaiter) func(context.Context) error {
@@ -43,12 +55,56 @@ func (b *Botanist) DestroyAllExtensionResources(ctx context.Context) error {
 	})
 }
 
+// EnsureNoStaleExtensionFinalizers checks that no extension resources with finalizers are left in the Shoot
+// namespace. It is executed as a preflight check before the namespace is deleted, since a leftover finalizer
+// would block the namespace deletion indefinitely without a clear signal to operators.
+func (b *Botanist) EnsureNoStaleExtensionFinalizers(ctx context.Context) error {
+	var stale []string
+
+	for _, l := range []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{extensionsv1alpha1.ContainerRuntimeResource, &extensionsv1alpha1.ContainerRuntimeList{}},
+		{extensionsv1alpha1.ControlPlaneResource, &extensionsv1alpha1.ControlPlaneList{}},
+		{extensionsv1alpha1.DNSRecordResource, &extensionsv1alpha1.DNSRecordList{}},
+		{extensionsv1alpha1.ExtensionResource, &extensionsv1alpha1.ExtensionList{}},
+		{extensionsv1alpha1.InfrastructureResource, &extensionsv1alpha1.InfrastructureList{}},
+		{extensionsv1alpha1.NetworkResource, &extensionsv1alpha1.NetworkList{}},
+		{extensionsv1alpha1.OperatingSystemConfigResource, &extensionsv1alpha1.OperatingSystemConfigList{}},
+		{extensionsv1alpha1.WorkerResource, &extensionsv1alpha1.WorkerList{}},
+	} {
+		if err := b.K8sSeedClient.Client().List(ctx, l.list, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+			return err
+		}
+
+		if err := meta.EachListItem(l.list, func(obj runtime.Object) error {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			if len(accessor.GetFinalizers()) > 0 {
+				stale = append(stale, fmt.Sprintf("%s/%s", l.kind, accessor.GetName()))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(stale) > 0 {
+		return fmt.Errorf("cannot delete namespace %q: the following extension resources still have finalizers: %s", b.Shoot.SeedNamespace, strings.Join(stale, ", "))
+	}
+
+	return nil
+}
+
 func (b *Botanist) runParallelTaskForEachExtensionComponent(ctx context.Context, fn func(component.DeployMigrateWaiter) func(context.Context) error) error {
 	var fns []flow.TaskFn
 	for _, component := range b.Shoot.GetExtensionComponentsForMigration() {
 		fns = append(fns, fn(component))
 	}
-	return flow.Parallel(fns...)(ctx)
+	return flow.ParallelN(ExtensionResourceMigrationConcurrency, fns...)(ctx)
 }
 
 func (b *Botanist) isRestorePhase() bool {
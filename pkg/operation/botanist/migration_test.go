@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/operation"
 	. "github.com/gardener/gardener/pkg/operation/botanist"
 	mockcontainerruntime "github.com/gardener/gardener/pkg/operation/botanist/component/extensions/containerruntime/mock"
@@ -33,6 +35,10 @@ import (
 	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("migration", func() {
@@ -148,6 +154,43 @@ var _ = Describe("migration", func() {
 		})
 	})
 
+	Describe("#EnsureNoStaleExtensionFinalizers", func() {
+		const seedNamespace = "shoot--foo--bar"
+
+		var seedClient client.Client
+
+		BeforeEach(func() {
+			s := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			seedClient = fake.NewClientBuilder().WithScheme(s).Build()
+
+			botanist.K8sSeedClient = fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build()
+			botanist.Shoot.SeedNamespace = seedNamespace
+		})
+
+		It("should succeed if no extension resources are left", func() {
+			Expect(botanist.EnsureNoStaleExtensionFinalizers(ctx)).To(Succeed())
+		})
+
+		It("should succeed if the remaining extension resources have no finalizers", func() {
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: seedNamespace},
+			})).To(Succeed())
+
+			Expect(botanist.EnsureNoStaleExtensionFinalizers(ctx)).To(Succeed())
+		})
+
+		It("should return a descriptive error naming the objects with finalizers", func() {
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: seedNamespace, Finalizers: []string{"gardener"}},
+			})).To(Succeed())
+
+			err := botanist.EnsureNoStaleExtensionFinalizers(ctx)
+			Expect(err).To(MatchError(ContainSubstring("still have finalizers")))
+			Expect(err).To(MatchError(ContainSubstring("Infrastructure/foo")))
+		})
+	})
+
 	Describe("#DestroyAllExtensionResources", func() {
 		It("should call the Destroy() func of all extension components", func() {
 			containerRuntime.EXPECT().Destroy(ctx)
@@ -85,6 +85,39 @@ func (b *Botanist) DeleteSeedNamespace(ctx context.Context) error {
 	return err
 }
 
+// SeedNamespaceState classifies the shoot namespace in the seed cluster with regard to how much cleanup
+// is still possible during deletion or migration of the shoot control plane.
+type SeedNamespaceState string
+
+const (
+	// SeedNamespaceStateNotFound means that the namespace does not exist, so there is nothing left to clean up.
+	SeedNamespaceStateNotFound SeedNamespaceState = "notFound"
+	// SeedNamespaceStateTerminating means that the namespace is already being deleted, so only limited cleanup
+	// is possible because resources in the namespace are already going away.
+	SeedNamespaceStateTerminating SeedNamespaceState = "terminating"
+	// SeedNamespaceStateActive means that the namespace exists and is not being deleted, so full cleanup of the
+	// resources deployed for the shoot is possible.
+	SeedNamespaceStateActive SeedNamespaceState = "active"
+)
+
+// FetchSeedNamespaceObject reads the namespace of the Shoot cluster within the Seed cluster into
+// b.SeedNamespaceObject and classifies its state. A "not found" error is not returned but reflected in the
+// returned state, any other read error is returned as-is.
+func (b *Botanist) FetchSeedNamespaceObject(ctx context.Context) (SeedNamespaceState, error) {
+	b.SeedNamespaceObject = &corev1.Namespace{}
+	if err := b.K8sSeedClient.APIReader().Get(ctx, client.ObjectKey{Name: b.Shoot.SeedNamespace}, b.SeedNamespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return SeedNamespaceStateNotFound, nil
+		}
+		return "", err
+	}
+
+	if b.SeedNamespaceObject.Status.Phase == corev1.NamespaceTerminating {
+		return SeedNamespaceStateTerminating, nil
+	}
+	return SeedNamespaceStateActive, nil
+}
+
 // WaitUntilSeedNamespaceDeleted waits until the namespace of the Shoot cluster within the Seed cluster is deleted.
 func (b *Botanist) WaitUntilSeedNamespaceDeleted(ctx context.Context) error {
 	return retry.UntilTimeout(ctx, 5*time.Second, 900*time.Second, func(ctx context.Context) (done bool, err error) {
@@ -36,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("Namespaces", func() {
@@ -158,6 +159,67 @@ var _ = Describe("Namespaces", func() {
 		})
 	})
 
+	Describe("#FetchSeedNamespaceObject", func() {
+		var reader *mockclient.MockReader
+
+		BeforeEach(func() {
+			reader = mockclient.NewMockReader(ctrl)
+		})
+
+		It("should return the not found state if the namespace does not exist", func() {
+			gomock.InOrder(
+				kubernetesClient.EXPECT().APIReader().Return(reader),
+				reader.EXPECT().Get(ctx, client.ObjectKey{Name: namespace}, gomock.AssignableToTypeOf(&corev1.Namespace{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+			)
+
+			state, err := botanist.FetchSeedNamespaceObject(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(SeedNamespaceStateNotFound))
+		})
+
+		It("should return the terminating state if the namespace is being deleted", func() {
+			gomock.InOrder(
+				kubernetesClient.EXPECT().APIReader().Return(reader),
+				reader.EXPECT().Get(ctx, client.ObjectKey{Name: namespace}, gomock.AssignableToTypeOf(&corev1.Namespace{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, ns *corev1.Namespace) error {
+					ns.Name = namespace
+					ns.Status.Phase = corev1.NamespaceTerminating
+					return nil
+				}),
+			)
+
+			state, err := botanist.FetchSeedNamespaceObject(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(SeedNamespaceStateTerminating))
+			Expect(botanist.SeedNamespaceObject.Status.Phase).To(Equal(corev1.NamespaceTerminating))
+		})
+
+		It("should return the active state if the namespace is not being deleted", func() {
+			gomock.InOrder(
+				kubernetesClient.EXPECT().APIReader().Return(reader),
+				reader.EXPECT().Get(ctx, client.ObjectKey{Name: namespace}, gomock.AssignableToTypeOf(&corev1.Namespace{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, ns *corev1.Namespace) error {
+					ns.Name = namespace
+					ns.Status.Phase = corev1.NamespaceActive
+					return nil
+				}),
+			)
+
+			state, err := botanist.FetchSeedNamespaceObject(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(SeedNamespaceStateActive))
+			Expect(botanist.SeedNamespaceObject.Name).To(Equal(namespace))
+		})
+
+		It("should return any other read error", func() {
+			gomock.InOrder(
+				kubernetesClient.EXPECT().APIReader().Return(reader),
+				reader.EXPECT().Get(ctx, client.ObjectKey{Name: namespace}, gomock.AssignableToTypeOf(&corev1.Namespace{})).Return(fakeErr),
+			)
+
+			_, err := botanist.FetchSeedNamespaceObject(ctx)
+			Expect(err).To(MatchError(fakeErr))
+		})
+	})
+
 	Describe("#DeleteSeedNamespace", func() {
 		It("should fail to delete the namespace", func() {
 			gomock.InOrder(
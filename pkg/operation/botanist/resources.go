@@ -17,44 +17,291 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	unstructuredutils "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	// ManagedResourceName is the name of the managed resource used to deploy referenced resources to the Seed cluster.
 	ManagedResourceName = "referenced-resources"
+
+	// ReferencedResourcesLabel is the key of a label set on all objects synced to the Seed cluster by
+	// DeployReferencedResources. It allows identifying previously synced objects that are no longer referenced
+	// by the shoot.
+	ReferencedResourcesLabel = "resources.gardener.cloud/referenced-resource"
+
+	// ReferencedResourcesHashAnnotation is the key of an annotation on the referenced-resources managed resource
+	// holding a hash of the resource references that were synced last. It allows skipping a redeployment when the
+	// references did not change between reconciles.
+	ReferencedResourcesHashAnnotation = "resources.gardener.cloud/referenced-resources-hash"
+
+	// OriginNotFoundAnnotation is the key of an annotation set by VerifyReferencedResourceOrigins on synced
+	// referenced resources whose garden-cluster source recorded in the origin annotation no longer exists.
+	OriginNotFoundAnnotation = "resources.gardener.cloud/origin-not-found"
 )
 
+// DefaultReferencedResourcesAllowedKinds are the group/kinds of referenced resources that DeployReferencedResources
+// syncs to the Seed cluster by default. Other kinds are rejected to prevent a misconfigured shoot from pulling
+// arbitrary (potentially privileged or cluster-scoped) objects into the seed.
+var DefaultReferencedResourcesAllowedKinds = []schema.GroupKind{
+	{Group: corev1.GroupName, Kind: "Secret"},
+	{Group: corev1.GroupName, Kind: "ConfigMap"},
+}
+
+// ValidateReferencedResources checks that the kinds of all given resource references are contained in the given
+// list of allowed group/kinds, returning an error naming the first disallowed reference otherwise.
+func ValidateReferencedResources(resources []gardencorev1beta1.NamedResourceReference, allowedKinds []schema.GroupKind) error {
+	for _, resource := range resources {
+		gv, err := schema.ParseGroupVersion(resource.ResourceRef.APIVersion)
+		if err != nil {
+			return fmt.Errorf("could not parse apiVersion %q of referenced resource %q: %w", resource.ResourceRef.APIVersion, resource.Name, err)
+		}
+
+		allowed := false
+		for _, kind := range allowedKinds {
+			if gv.Group == kind.Group && resource.ResourceRef.Kind == kind.Kind {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("referenced resource %q of kind %q in group %q is not allowed to be synced to the seed", resource.Name, resource.ResourceRef.Kind, gv.Group)
+		}
+	}
+
+	return nil
+}
+
+// ComputeReferencedResourcesHash computes a hash over the given resource references. The hash is independent
+// of the order of the references, so that reordering them in the shoot spec does not trigger a redeployment.
+func ComputeReferencedResourcesHash(resources []gardencorev1beta1.NamedResourceReference) string {
+	keys := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		keys = append(keys, fmt.Sprintf("%s|%s|%s|%s", resource.Name, resource.ResourceRef.APIVersion, resource.ResourceRef.Kind, resource.ResourceRef.Name))
+	}
+	sort.Strings(keys)
+	return utils.ComputeSHA256Hex([]byte(strings.Join(keys, "\n")))
+}
+
+// ReferencedResourcesChanged returns whether the shoot's resource references changed since the last sync by
+// comparing their hash against the hash annotation on the referenced-resources managed resource. It returns
+// true if the managed resource does not exist yet or carries no hash annotation.
+func (b *Botanist) ReferencedResourcesChanged(ctx context.Context) (bool, error) {
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, ManagedResourceName), managedResource); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	lastHash, ok := managedResource.Annotations[ReferencedResourcesHashAnnotation]
+	return !ok || lastHash != ComputeReferencedResourcesHash(b.Shoot.Info.Spec.Resources), nil
+}
+
 // DeployReferencedResources reads all referenced resources from the Garden cluster and writes a managed resource to the Seed cluster.
+// If the shoot's resource references did not change since the last sync, the deployment is skipped.
 func (b *Botanist) DeployReferencedResources(ctx context.Context) error {
+	// During restore the referenced resources are re-deployed into the target seed unconditionally, since
+	// they do not exist there yet even if the shoot's resource references are unchanged.
+	if b.isRestorePhase() {
+		return b.DeployReferencedResourcesToSeed(ctx, b.K8sSeedClient.Client())
+	}
+
+	if err := ValidateReferencedResources(b.Shoot.Info.Spec.Resources, DefaultReferencedResourcesAllowedKinds); err != nil {
+		return err
+	}
+
+	changed, err := b.ReferencedResourcesChanged(ctx)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+
+	return b.deployReferencedResources(ctx, b.K8sSeedClient.Client())
+}
+
+// DeployReferencedResourcesToSeed is like DeployReferencedResources but writes the managed resource using the
+// given seed client instead of the botanist's own, e.g. to re-deploy the referenced resources into the target
+// seed during control plane migration. The hash-based skip is not applied, since the given seed may not
+// contain the resources yet even if the shoot's resource references are unchanged.
+func (b *Botanist) DeployReferencedResourcesToSeed(ctx context.Context, seedClient client.Client) error {
+	if err := ValidateReferencedResources(b.Shoot.Info.Spec.Resources, DefaultReferencedResourcesAllowedKinds); err != nil {
+		return err
+	}
+
+	return b.deployReferencedResources(ctx, seedClient)
+}
+
+// deployReferencedResources reads all referenced resources from the Garden cluster and writes a managed
+// resource to the seed cluster accessed by the given client.
+func (b *Botanist) deployReferencedResources(ctx context.Context, seedClient client.Client) error {
 	// Read referenced objects into a slice of unstructured objects
 	var unstructuredObjs []*unstructured.Unstructured
 	for _, resource := range b.Shoot.Info.Spec.Resources {
-		// Read the resource from the Garden cluster
-		obj, err := unstructuredutils.GetObjectByRef(ctx, b.K8sGardenClient.Client(), &resource.ResourceRef, b.Shoot.Info.Namespace)
+		gv, err := schema.ParseGroupVersion(resource.ResourceRef.APIVersion)
 		if err != nil {
 			return err
 		}
-		if obj == nil {
-			return fmt.Errorf("object not found %v", resource.ResourceRef)
+
+		// Read the resource from the Garden cluster. The object is read directly instead of via
+		// unstructuredutils.GetObjectByRef so that the source resource version is available for the
+		// origin annotation.
+		srcObj := &unstructured.Unstructured{}
+		srcObj.SetGroupVersionKind(gv.WithKind(resource.ResourceRef.Kind))
+		if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, resource.ResourceRef.Name), srcObj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("object not found %v", resource.ResourceRef)
+			}
+			return err
 		}
 
 		// Create an unstructured object and append it to the slice
-		unstructuredObj := &unstructured.Unstructured{Object: obj}
+		unstructuredObj := &unstructured.Unstructured{Object: unstructuredutils.FilterSystemMetadata(srcObj.UnstructuredContent())}
 		unstructuredObj.SetNamespace(b.Shoot.SeedNamespace)
 		unstructuredObj.SetName(v1beta1constants.ReferencedResourcesPrefix + unstructuredObj.GetName())
+
+		labels := unstructuredObj.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string, 1)
+		}
+		labels[ReferencedResourcesLabel] = "true"
+		unstructuredObj.SetLabels(labels)
+
+		// Record the garden-cluster source of the object for auditability
+		annotations := unstructuredObj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[unstructuredutils.OriginAnnotation] = fmt.Sprintf("%s/%s/%s", b.Shoot.Info.Namespace, resource.ResourceRef.Name, srcObj.GetResourceVersion())
+		unstructuredObj.SetAnnotations(annotations)
+
 		unstructuredObjs = append(unstructuredObjs, unstructuredObj)
 	}
 
 	// Create managed resource from the slice of unstructured objects
-	return managedresources.CreateFromUnstructured(ctx, b.K8sSeedClient.Client(), b.Shoot.SeedNamespace, ManagedResourceName,
-		false, v1beta1constants.SeedResourceManagerClass, unstructuredObjs, false, nil)
+	if err := managedresources.CreateFromUnstructured(ctx, seedClient, b.Shoot.SeedNamespace, ManagedResourceName,
+		false, v1beta1constants.SeedResourceManagerClass, unstructuredObjs, false, nil); err != nil {
+		return err
+	}
+
+	// Record the hash of the synced references so that the next reconciliation can detect whether they changed
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := seedClient.Get(ctx, kutil.Key(b.Shoot.SeedNamespace, ManagedResourceName), managedResource); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(managedResource.DeepCopy())
+	kutil.SetMetaDataAnnotation(&managedResource.ObjectMeta, ReferencedResourcesHashAnnotation, ComputeReferencedResourcesHash(b.Shoot.Info.Spec.Resources))
+	return seedClient.Patch(ctx, managedResource, patch)
+}
+
+// CleanupOrphanedReferencedResources deletes all objects in the seed namespace that are labeled as synced
+// referenced resources but are no longer referenced by the shoot, e.g. after the shoot's resource references
+// have been shrunk.
+func (b *Botanist) CleanupOrphanedReferencedResources(ctx context.Context) error {
+	// Key the set by kind and name so that e.g. an orphaned ConfigMap is still deleted when a referenced
+	// Secret with the same name exists.
+	referencedKindNames := sets.NewString()
+	for _, resource := range b.Shoot.Info.Spec.Resources {
+		referencedKindNames.Insert(resource.ResourceRef.Kind + "/" + v1beta1constants.ReferencedResourcesPrefix + resource.ResourceRef.Name)
+	}
+
+	for _, l := range []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{"Secret", &corev1.SecretList{}},
+		{"ConfigMap", &corev1.ConfigMapList{}},
+	} {
+		if err := b.K8sSeedClient.Client().List(ctx, l.list, client.InNamespace(b.Shoot.SeedNamespace),
+			client.MatchingLabels{ReferencedResourcesLabel: "true"}); err != nil {
+			return err
+		}
+
+		kind := l.kind
+		if err := meta.EachListItem(l.list, func(obj runtime.Object) error {
+			clientObj := obj.(client.Object)
+			if referencedKindNames.Has(kind + "/" + clientObj.GetName()) {
+				return nil
+			}
+			return client.IgnoreNotFound(b.K8sSeedClient.Client().Delete(ctx, clientObj))
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyReferencedResourceOrigins checks for all objects synced to the seed namespace by
+// DeployReferencedResources whether the garden-cluster source recorded in their origin annotation still
+// exists. Objects whose source is gone are flagged with the OriginNotFoundAnnotation so that stale copies
+// can be spotted by operators; the flag is removed again should the source reappear.
+func (b *Botanist) VerifyReferencedResourceOrigins(ctx context.Context) error {
+	for _, list := range []client.ObjectList{&corev1.SecretList{}, &corev1.ConfigMapList{}} {
+		if err := b.K8sSeedClient.Client().List(ctx, list, client.InNamespace(b.Shoot.SeedNamespace),
+			client.MatchingLabels{ReferencedResourcesLabel: "true"}); err != nil {
+			return err
+		}
+
+		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			clientObj := obj.(client.Object)
+
+			origin, ok := clientObj.GetAnnotations()[unstructuredutils.OriginAnnotation]
+			if !ok {
+				return nil
+			}
+			parts := strings.Split(origin, "/")
+			if len(parts) != 3 {
+				return fmt.Errorf("synced object %q has a malformed origin annotation %q", clientObj.GetName(), origin)
+			}
+
+			originExists := true
+			if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(parts[0], parts[1]), clientObj.DeepCopyObject().(client.Object)); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return err
+				}
+				originExists = false
+			}
+
+			// Nothing to do if the flag already reflects the state of the origin.
+			if _, flagged := clientObj.GetAnnotations()[OriginNotFoundAnnotation]; flagged == !originExists {
+				return nil
+			}
+
+			patch := client.MergeFrom(clientObj.DeepCopyObject().(client.Object))
+			if originExists {
+				delete(clientObj.GetAnnotations(), OriginNotFoundAnnotation)
+			} else {
+				kutil.SetMetaDataAnnotation(clientObj, OriginNotFoundAnnotation, "true")
+			}
+			return b.K8sSeedClient.Client().Patch(ctx, clientObj, patch)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // DestroyReferencedResources deletes the managed resource containing referenced resources from the Seed cluster.
@@ -0,0 +1,458 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"regexp"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	unstructuredutils "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Resources", func() {
+	Describe("#ValidateReferencedResources", func() {
+		var resources []gardencorev1beta1.NamedResourceReference
+
+		BeforeEach(func() {
+			resources = []gardencorev1beta1.NamedResourceReference{
+				{
+					Name: "foo",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{
+						Kind:       "Secret",
+						Name:       "foo-secret",
+						APIVersion: "v1",
+					},
+				},
+			}
+		})
+
+		It("should allow a referenced Secret", func() {
+			Expect(ValidateReferencedResources(resources, DefaultReferencedResourcesAllowedKinds)).To(Succeed())
+		})
+
+		It("should allow a referenced ConfigMap", func() {
+			resources[0].ResourceRef.Kind = "ConfigMap"
+			Expect(ValidateReferencedResources(resources, DefaultReferencedResourcesAllowedKinds)).To(Succeed())
+		})
+
+		It("should reject a disallowed kind", func() {
+			resources = append(resources, gardencorev1beta1.NamedResourceReference{
+				Name: "bar",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					Kind:       "ClusterRole",
+					Name:       "bar-role",
+					APIVersion: "rbac.authorization.k8s.io/v1",
+				},
+			})
+
+			err := ValidateReferencedResources(resources, DefaultReferencedResourcesAllowedKinds)
+			Expect(err).To(MatchError(ContainSubstring("ClusterRole")))
+		})
+
+		It("should reject an unparsable apiVersion", func() {
+			resources[0].ResourceRef.APIVersion = "a/b/c"
+			Expect(ValidateReferencedResources(resources, DefaultReferencedResourcesAllowedKinds)).NotTo(Succeed())
+		})
+
+		It("should respect a custom allowlist", func() {
+			err := ValidateReferencedResources(resources, []schema.GroupKind{{Group: "", Kind: "ConfigMap"}})
+			Expect(err).To(MatchError(ContainSubstring("Secret")))
+		})
+	})
+
+	Describe("#ComputeReferencedResourcesHash", func() {
+		var resources []gardencorev1beta1.NamedResourceReference
+
+		BeforeEach(func() {
+			resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "foo-secret", APIVersion: "v1"}},
+				{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "ConfigMap", Name: "bar-configmap", APIVersion: "v1"}},
+			}
+		})
+
+		It("should be independent of the order of the references", func() {
+			reordered := []gardencorev1beta1.NamedResourceReference{resources[1], resources[0]}
+			Expect(ComputeReferencedResourcesHash(reordered)).To(Equal(ComputeReferencedResourcesHash(resources)))
+		})
+
+		It("should change when a reference is added or modified", func() {
+			hash := ComputeReferencedResourcesHash(resources)
+
+			Expect(ComputeReferencedResourcesHash(resources[:1])).NotTo(Equal(hash))
+
+			resources[0].ResourceRef.Name = "other-secret"
+			Expect(ComputeReferencedResourcesHash(resources)).NotTo(Equal(hash))
+		})
+	})
+
+	Describe("#DeployReferencedResources", func() {
+		const (
+			shootNamespace = "garden-foo"
+			seedNamespace  = "shoot--foo--bar"
+		)
+
+		var (
+			ctx = context.TODO()
+
+			gardenClient client.Client
+			seedClient   client.Client
+
+			botanist *Botanist
+		)
+
+		BeforeEach(func() {
+			s := runtime.NewScheme()
+			Expect(corev1.AddToScheme(s)).To(Succeed())
+			Expect(resourcesv1alpha1.AddToScheme(s)).To(Succeed())
+
+			fooSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-secret",
+					Namespace: shootNamespace,
+				},
+				Data: map[string][]byte{"foo": []byte("bar")},
+			}
+			barConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bar-configmap",
+					Namespace: shootNamespace,
+				},
+				Data: map[string]string{"bar": "baz"},
+			}
+
+			gardenClient = fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(fooSecret, barConfigMap).Build()
+			seedClient = fake.NewClientBuilder().WithScheme(s).Build()
+
+			botanist = &Botanist{
+				Operation: &operation.Operation{
+					K8sGardenClient: fakekubernetes.NewClientSetBuilder().WithClient(gardenClient).Build(),
+					K8sSeedClient:   fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+					Shoot: &shootpkg.Shoot{
+						Info: &gardencorev1beta1.Shoot{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "bar",
+								Namespace: shootNamespace,
+							},
+							Spec: gardencorev1beta1.ShootSpec{
+								Resources: []gardencorev1beta1.NamedResourceReference{
+									{
+										Name: "foo",
+										ResourceRef: autoscalingv1.CrossVersionObjectReference{
+											Kind:       "Secret",
+											Name:       "foo-secret",
+											APIVersion: "v1",
+										},
+									},
+									{
+										Name: "bar",
+										ResourceRef: autoscalingv1.CrossVersionObjectReference{
+											Kind:       "ConfigMap",
+											Name:       "bar-configmap",
+											APIVersion: "v1",
+										},
+									},
+								},
+							},
+						},
+						SeedNamespace: seedNamespace,
+					},
+				},
+			}
+		})
+
+		managedResourceData := func() string {
+			secret := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace, Name: ManagedResourceName}, secret)).To(Succeed())
+			return string(secret.Data[ManagedResourceName])
+		}
+
+		It("should sync all referenced objects to the managed resource", func() {
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace, Name: ManagedResourceName}, managedResource)).To(Succeed())
+
+			data := managedResourceData()
+			Expect(data).To(ContainSubstring(v1beta1constants.ReferencedResourcesPrefix + "foo-secret"))
+			Expect(data).To(ContainSubstring(v1beta1constants.ReferencedResourcesPrefix + "bar-configmap"))
+		})
+
+		It("should drop objects whose references were removed from the spec on redeploy", func() {
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+			botanist.Shoot.Info.Spec.Resources = botanist.Shoot.Info.Spec.Resources[:1]
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+			// The managed resource data no longer contains the removed object, so the
+			// gardener-resource-manager garbage-collects it from the seed.
+			data := managedResourceData()
+			Expect(data).To(ContainSubstring(v1beta1constants.ReferencedResourcesPrefix + "foo-secret"))
+			Expect(data).NotTo(ContainSubstring(v1beta1constants.ReferencedResourcesPrefix + "bar-configmap"))
+		})
+
+		It("should annotate synced objects with their garden-cluster source", func() {
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+			data := managedResourceData()
+			Expect(data).To(ContainSubstring(unstructuredutils.OriginAnnotation))
+			Expect(data).To(ContainSubstring(shootNamespace + "/foo-secret/"))
+			Expect(data).To(ContainSubstring(shootNamespace + "/bar-configmap/"))
+		})
+
+		It("should update the origin annotation when the source resource version changes", func() {
+			originFor := regexp.MustCompile(shootNamespace + "/foo-secret/[0-9]+")
+
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+			originBefore := originFor.FindString(managedResourceData())
+			Expect(originBefore).NotTo(BeEmpty())
+
+			// Update the referenced object and force a redeployment by changing the references.
+			fooSecret := &corev1.Secret{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace, Name: "foo-secret"}, fooSecret)).To(Succeed())
+			fooSecret.Data["foo"] = []byte("changed")
+			Expect(gardenClient.Update(ctx, fooSecret)).To(Succeed())
+			botanist.Shoot.Info.Spec.Resources = botanist.Shoot.Info.Spec.Resources[:1]
+
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+			originAfter := originFor.FindString(managedResourceData())
+			Expect(originAfter).NotTo(BeEmpty())
+			Expect(originAfter).NotTo(Equal(originBefore))
+		})
+
+		It("should skip the deployment if the resource references are unchanged", func() {
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+			dataBefore := managedResourceData()
+
+			// Change the content of a referenced object without touching the references themselves.
+			fooSecret := &corev1.Secret{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace, Name: "foo-secret"}, fooSecret)).To(Succeed())
+			fooSecret.Data["foo"] = []byte("changed")
+			Expect(gardenClient.Update(ctx, fooSecret)).To(Succeed())
+
+			Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+			Expect(managedResourceData()).To(Equal(dataBefore))
+		})
+
+		Describe("#ReferencedResourcesChanged", func() {
+			It("should return true if the managed resource does not exist yet", func() {
+				Expect(botanist.ReferencedResourcesChanged(ctx)).To(BeTrue())
+			})
+
+			It("should return false directly after a deployment", func() {
+				Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+				Expect(botanist.ReferencedResourcesChanged(ctx)).To(BeFalse())
+			})
+
+			It("should return true if the references changed since the last deployment", func() {
+				Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+				botanist.Shoot.Info.Spec.Resources = botanist.Shoot.Info.Spec.Resources[:1]
+				Expect(botanist.ReferencedResourcesChanged(ctx)).To(BeTrue())
+			})
+		})
+
+		It("should fail if a referenced object does not exist", func() {
+			botanist.Shoot.Info.Spec.Resources[0].ResourceRef.Name = "missing-secret"
+
+			Expect(botanist.DeployReferencedResources(ctx)).To(MatchError(ContainSubstring("object not found")))
+		})
+
+		Describe("#CleanupOrphanedReferencedResources", func() {
+			var stillReferenced, orphaned, unrelated *corev1.Secret
+
+			BeforeEach(func() {
+				stillReferenced = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      v1beta1constants.ReferencedResourcesPrefix + "foo-secret",
+						Namespace: seedNamespace,
+						Labels:    map[string]string{ReferencedResourcesLabel: "true"},
+					},
+				}
+				orphaned = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      v1beta1constants.ReferencedResourcesPrefix + "old-secret",
+						Namespace: seedNamespace,
+						Labels:    map[string]string{ReferencedResourcesLabel: "true"},
+					},
+				}
+				unrelated = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "unrelated-secret",
+						Namespace: seedNamespace,
+					},
+				}
+
+				for _, obj := range []client.Object{stillReferenced, orphaned, unrelated} {
+					Expect(seedClient.Create(ctx, obj)).To(Succeed())
+				}
+			})
+
+			It("should delete orphaned objects but keep still-referenced and unlabeled ones", func() {
+				Expect(botanist.CleanupOrphanedReferencedResources(ctx)).To(Succeed())
+
+				err := seedClient.Get(ctx, client.ObjectKeyFromObject(orphaned), &corev1.Secret{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(stillReferenced), &corev1.Secret{})).To(Succeed())
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(unrelated), &corev1.Secret{})).To(Succeed())
+			})
+
+			It("should delete an orphaned object sharing its name with a still-referenced object of another kind", func() {
+				orphanedConfigMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      stillReferenced.Name,
+						Namespace: seedNamespace,
+						Labels:    map[string]string{ReferencedResourcesLabel: "true"},
+					},
+				}
+				Expect(seedClient.Create(ctx, orphanedConfigMap)).To(Succeed())
+
+				Expect(botanist.CleanupOrphanedReferencedResources(ctx)).To(Succeed())
+
+				err := seedClient.Get(ctx, client.ObjectKeyFromObject(orphanedConfigMap), &corev1.ConfigMap{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(stillReferenced), &corev1.Secret{})).To(Succeed())
+			})
+		})
+
+		Describe("#VerifyReferencedResourceOrigins", func() {
+			var validOrigin, danglingOrigin *corev1.Secret
+
+			BeforeEach(func() {
+				validOrigin = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        v1beta1constants.ReferencedResourcesPrefix + "foo-secret",
+						Namespace:   seedNamespace,
+						Labels:      map[string]string{ReferencedResourcesLabel: "true"},
+						Annotations: map[string]string{unstructuredutils.OriginAnnotation: shootNamespace + "/foo-secret/1"},
+					},
+				}
+				danglingOrigin = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        v1beta1constants.ReferencedResourcesPrefix + "gone-secret",
+						Namespace:   seedNamespace,
+						Labels:      map[string]string{ReferencedResourcesLabel: "true"},
+						Annotations: map[string]string{unstructuredutils.OriginAnnotation: shootNamespace + "/gone-secret/5"},
+					},
+				}
+
+				for _, obj := range []client.Object{validOrigin, danglingOrigin} {
+					Expect(seedClient.Create(ctx, obj)).To(Succeed())
+				}
+			})
+
+			It("should flag objects with a dangling origin but not those with an existing one", func() {
+				Expect(botanist.VerifyReferencedResourceOrigins(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(danglingOrigin), danglingOrigin)).To(Succeed())
+				Expect(danglingOrigin.Annotations).To(HaveKeyWithValue(OriginNotFoundAnnotation, "true"))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(validOrigin), validOrigin)).To(Succeed())
+				Expect(validOrigin.Annotations).NotTo(HaveKey(OriginNotFoundAnnotation))
+			})
+
+			It("should remove the flag again if the origin exists", func() {
+				validOrigin.Annotations[OriginNotFoundAnnotation] = "true"
+				Expect(seedClient.Update(ctx, validOrigin)).To(Succeed())
+
+				Expect(botanist.VerifyReferencedResourceOrigins(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(validOrigin), secret)).To(Succeed())
+				Expect(secret.Annotations).NotTo(HaveKey(OriginNotFoundAnnotation))
+			})
+
+			It("should fail for a malformed origin annotation", func() {
+				danglingOrigin.Annotations[unstructuredutils.OriginAnnotation] = "garbage"
+				Expect(seedClient.Update(ctx, danglingOrigin)).To(Succeed())
+
+				Expect(botanist.VerifyReferencedResourceOrigins(ctx)).To(MatchError(ContainSubstring("malformed origin annotation")))
+			})
+		})
+
+		Describe("#DeployReferencedResourcesToSeed", func() {
+			It("should deploy the managed resource into the given target seed", func() {
+				s := runtime.NewScheme()
+				Expect(corev1.AddToScheme(s)).To(Succeed())
+				Expect(resourcesv1alpha1.AddToScheme(s)).To(Succeed())
+				targetSeedClient := fake.NewClientBuilder().WithScheme(s).Build()
+
+				Expect(botanist.DeployReferencedResourcesToSeed(ctx, targetSeedClient)).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{}
+				Expect(targetSeedClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace, Name: ManagedResourceName}, managedResource)).To(Succeed())
+
+				// The source seed remains untouched.
+				err := seedClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace, Name: ManagedResourceName}, &resourcesv1alpha1.ManagedResource{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should re-deploy during restore even if the references are unchanged", func() {
+				originFor := regexp.MustCompile(shootNamespace + "/foo-secret/[0-9]+")
+
+				Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+				originBefore := originFor.FindString(managedResourceData())
+				Expect(originBefore).NotTo(BeEmpty())
+
+				// Update the referenced object without changing the references. A regular reconciliation
+				// would skip the redeployment, the restore phase must not.
+				fooSecret := &corev1.Secret{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace, Name: "foo-secret"}, fooSecret)).To(Succeed())
+				fooSecret.Data["foo"] = []byte("changed")
+				Expect(gardenClient.Update(ctx, fooSecret)).To(Succeed())
+
+				botanist.Shoot.Info.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore}
+				Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+
+				originAfter := originFor.FindString(managedResourceData())
+				Expect(originAfter).NotTo(Equal(originBefore))
+			})
+		})
+
+		Describe("#DestroyReferencedResources", func() {
+			It("should delete the managed resource", func() {
+				Expect(botanist.DeployReferencedResources(ctx)).To(Succeed())
+				Expect(botanist.DestroyReferencedResources(ctx)).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{}
+				err := seedClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace, Name: ManagedResourceName}, managedResource)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should not fail if the managed resource does not exist", func() {
+				Expect(botanist.DestroyReferencedResources(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -42,6 +43,7 @@ import (
 	"github.com/Masterminds/semver"
 	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -383,6 +385,68 @@ func (s *Shoot) ComputeOutOfClusterAPIServerAddress(apiServerAddress string, use
 	return gutil.GetAPIServerDomain(*s.ExternalClusterDomain)
 }
 
+// ComputeAPIServerSANs returns all names under which the shoot API server is reachable and which therefore have to
+// be part of its server certificate: the in-cluster service names, the internal and external API server domains (if
+// DNS is not disabled for this seed), and the given address. Duplicates are removed while the order is kept stable.
+func (s *Shoot) ComputeAPIServerSANs(apiServerAddress string) []string {
+	var sans []string
+
+	add := func(name string) {
+		if name == "" {
+			return
+		}
+		for _, san := range sans {
+			if san == name {
+				return
+			}
+		}
+		sans = append(sans, name)
+	}
+
+	add(s.ComputeInClusterAPIServerAddress(true))
+	add(s.ComputeInClusterAPIServerAddress(false))
+
+	if !s.DisableDNS {
+		add(gutil.GetAPIServerDomain(s.InternalClusterDomain))
+		// With unmanaged DNS only the internal cluster domain is managed by Gardener, so there is no external
+		// API server domain to add.
+		if !gardencorev1beta1helper.ShootUsesUnmanagedDNS(s.Info) && s.ExternalClusterDomain != nil {
+			add(gutil.GetAPIServerDomain(*s.ExternalClusterDomain))
+		}
+	}
+
+	add(apiServerAddress)
+
+	return sans
+}
+
+// ResolveDomains returns the internal and the external cluster domain of the shoot in a single place so that all
+// callers agree on the outcome. If DNS is disabled for this seed no domains are returned. If the shoot uses
+// unmanaged DNS only the internal cluster domain is managed by Gardener, so it also serves as the external one.
+func (s *Shoot) ResolveDomains(internalDomain *garden.Domain) (string, string, error) {
+	if s.DisableDNS {
+		return "", "", nil
+	}
+
+	if internalDomain == nil {
+		return "", "", fmt.Errorf("internal domain is not configured")
+	}
+
+	internal := s.InternalClusterDomain
+
+	var external string
+	switch {
+	case gardencorev1beta1helper.ShootUsesUnmanagedDNS(s.Info):
+		external = internal
+	case s.ExternalClusterDomain != nil:
+		external = *s.ExternalClusterDomain
+	default:
+		return "", "", fmt.Errorf("external domain is not configured for shoot %q", s.Info.Name)
+	}
+
+	return internal, external, nil
+}
+
 // IPVSEnabled returns true if IPVS is enabled for the shoot.
 func (s *Shoot) IPVSEnabled() bool {
 	return s.Info.Spec.Kubernetes.KubeProxy != nil &&
@@ -462,10 +526,19 @@ func ConstructExternalDomain(ctx context.Context, client client.Client, shoot *g
 		externalDomain.ExcludeZones = defaultDomain.ExcludeZones
 
 	case primaryProvider != nil:
+		if count := countPrimaryDNSProviders(shoot.Spec.DNS.Providers); count > 1 {
+			return nil, fmt.Errorf("only one primary DNS provider is allowed, but found %d", count)
+		}
+		if err := validatePrimaryDNSProvider(primaryProvider, shootSecret); err != nil {
+			return nil, err
+		}
 		if primaryProvider.SecretName != nil {
 			secret := &corev1.Secret{}
 			if err := client.Get(ctx, kutil.Key(shoot.Namespace, *primaryProvider.SecretName), secret); err != nil {
-				return nil, fmt.Errorf("could not get dns provider secret %q: %+v", *shoot.Spec.DNS.Providers[0].SecretName, err)
+				if apierrors.IsNotFound(err) {
+					return nil, &DNSProviderSecretNotFoundError{fmt.Errorf("dns provider secret %q not found: %+v", *primaryProvider.SecretName, err)}
+				}
+				return nil, fmt.Errorf("could not get dns provider secret %q: %+v", *primaryProvider.SecretName, err)
 			}
 			externalDomain.SecretData = secret.Data
 		} else {
@@ -493,9 +566,41 @@ func ConstructExternalDomain(ctx context.Context, client client.Client, shoot *g
 	return externalDomain, nil
 }
 
+// validatePrimaryDNSProvider checks that credentials are available for the given primary DNS provider. A primary
+// provider without an own secret reference can only fall back to the shoot's cloud provider secret, so if that is
+// not available either (and no default domain applied before this check), the domain source would be undefined.
+func validatePrimaryDNSProvider(primaryProvider *gardencorev1beta1.DNSProvider, shootSecret *corev1.Secret) error {
+	if primaryProvider.SecretName == nil && shootSecret == nil {
+		providerType := "unknown"
+		if primaryProvider.Type != nil {
+			providerType = *primaryProvider.Type
+		}
+		return fmt.Errorf("primary DNS provider of type %q does not reference a secret, and neither a default domain nor the shoot's cloud provider secret applies", providerType)
+	}
+	return nil
+}
+
+// countPrimaryDNSProviders returns the number of DNS providers marked as primary.
+func countPrimaryDNSProviders(providers []gardencorev1beta1.DNSProvider) int {
+	var count int
+	for _, provider := range providers {
+		if provider.Primary != nil && *provider.Primary {
+			count++
+		}
+	}
+	return count
+}
+
 // ToNetworks return a network with computed cidrs and ClusterIPs
 // for a Shoot
 func ToNetworks(s *gardencorev1beta1.Shoot) (*Networks, error) {
+	return ToNetworksWithPreferredServiceIPFamily(s, "")
+}
+
+// ToNetworksWithPreferredServiceIPFamily is like ToNetworks, but computes the cluster IPs of the default/kubernetes
+// and CoreDNS services from the services CIDR matching the given IP family if multiple (comma-separated) services
+// CIDRs are configured. If no preference is given or no CIDR matches the preferred family, the first CIDR is used.
+func ToNetworksWithPreferredServiceIPFamily(s *gardencorev1beta1.Shoot, preferredFamily corev1.IPFamily) (*Networks, error) {
 	if s.Spec.Networking.Services == nil {
 		return nil, fmt.Errorf("shoot's service cidr is empty")
 	}
@@ -504,9 +609,19 @@ func ToNetworks(s *gardencorev1beta1.Shoot) (*Networks, error) {
 		return nil, fmt.Errorf("shoot's pods cidr is empty")
 	}
 
-	_, svc, err := net.ParseCIDR(*s.Spec.Networking.Services)
-	if err != nil {
-		return nil, fmt.Errorf("cannot parse shoot's network cidr %v", err)
+	var (
+		svcCIDRs []string
+		svcNets  []*net.IPNet
+	)
+
+	for _, cidr := range strings.Split(*s.Spec.Networking.Services, ",") {
+		cidr = strings.TrimSpace(cidr)
+		_, svc, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse shoot's network cidr %v", err)
+		}
+		svcCIDRs = append(svcCIDRs, cidr)
+		svcNets = append(svcNets, svc)
 	}
 
 	_, pods, err := net.ParseCIDR(*s.Spec.Networking.Pods)
@@ -514,12 +629,43 @@ func ToNetworks(s *gardencorev1beta1.Shoot) (*Networks, error) {
 		return nil, fmt.Errorf("cannot parse shoot's network cidr %v", err)
 	}
 
-	apiserver, err := common.ComputeOffsetIP(svc, 1)
+	for _, svc := range svcNets {
+		if cidrsIntersect(pods, svc) {
+			return nil, fmt.Errorf("shoot's pods cidr %q overlaps with services cidr %q", pods, svc)
+		}
+	}
+
+	if s.Spec.Networking.Nodes != nil {
+		_, nodes, err := net.ParseCIDR(*s.Spec.Networking.Nodes)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse shoot's network cidr %v", err)
+		}
+		if cidrsIntersect(pods, nodes) {
+			return nil, fmt.Errorf("shoot's pods cidr %q overlaps with nodes cidr %q", pods, nodes)
+		}
+		for _, svc := range svcNets {
+			if cidrsIntersect(svc, nodes) {
+				return nil, fmt.Errorf("shoot's services cidr %q overlaps with nodes cidr %q", svc, nodes)
+			}
+		}
+	}
+
+	clusterIPCIDR := svcCIDRs[0]
+	if preferredFamily != "" {
+		for i, svc := range svcNets {
+			if ipFamilyOf(svc) == preferredFamily {
+				clusterIPCIDR = svcCIDRs[i]
+				break
+			}
+		}
+	}
+
+	apiserver, err := ComputeServiceIP(clusterIPCIDR, 1)
 	if err != nil {
 		return nil, fmt.Errorf("cannot calculate default/kubernetes ClusterIP: %v", err)
 	}
 
-	coreDNS, err := common.ComputeOffsetIP(svc, 10)
+	coreDNS, err := ComputeServiceIP(clusterIPCIDR, 10)
 	if err != nil {
 		return nil, fmt.Errorf("cannot calculate CoreDNS ClusterIP: %v", err)
 	}
@@ -527,16 +673,140 @@ func ToNetworks(s *gardencorev1beta1.Shoot) (*Networks, error) {
 	return &Networks{
 		CoreDNS:   coreDNS,
 		Pods:      pods,
-		Services:  svc,
+		Services:  svcNets[0],
 		APIServer: apiserver,
 	}, nil
 }
 
+// CoreDNSClusterIP returns the cluster IP of the CoreDNS service computed from the shoot's services CIDR.
+// An error is returned if the services CIDR is missing, invalid, or too small to accommodate the CoreDNS
+// cluster IP.
+func (s *Shoot) CoreDNSClusterIP() (net.IP, error) {
+	networks, err := ToNetworks(s.Info)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute CoreDNS cluster IP: %v", err)
+	}
+	return networks.CoreDNS, nil
+}
+
+// ipFamilyOf returns the IP family of the given network.
+func ipFamilyOf(n *net.IPNet) corev1.IPFamily {
+	if n.IP.To4() == nil {
+		return corev1.IPv6Protocol
+	}
+	return corev1.IPv4Protocol
+}
+
+// cidrsIntersect returns true if the given networks share at least one IP address.
+func cidrsIntersect(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// ComputeServiceIP computes the ClusterIP with the given offset in the given service CIDR, e.g. offset 1 for the
+// kubernetes service and offset 10 for CoreDNS. It returns an error if the CIDR cannot be parsed or the subnet is
+// too small for the offset.
+func ComputeServiceIP(cidr string, offset int) (net.IP, error) {
+	_, svc, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse service cidr %q: %v", cidr, err)
+	}
+
+	return common.ComputeOffsetIP(svc, int64(offset))
+}
+
+// Diff compares the Networks with the given other Networks and returns a list of human-readable differences.
+// An empty list means that both Networks are equal.
+func (n *Networks) Diff(other *Networks) []string {
+	var (
+		diffs []string
+
+		ipNetString = func(ipNet *net.IPNet) string {
+			if ipNet == nil {
+				return "<none>"
+			}
+			return ipNet.String()
+		}
+		ipString = func(ip net.IP) string {
+			if ip == nil {
+				return "<none>"
+			}
+			return ip.String()
+		}
+	)
+
+	if ipNetString(n.Pods) != ipNetString(other.Pods) {
+		diffs = append(diffs, fmt.Sprintf("pods network changed from %s to %s", ipNetString(n.Pods), ipNetString(other.Pods)))
+	}
+	if ipNetString(n.Services) != ipNetString(other.Services) {
+		diffs = append(diffs, fmt.Sprintf("services network changed from %s to %s", ipNetString(n.Services), ipNetString(other.Services)))
+	}
+	if ipString(n.APIServer) != ipString(other.APIServer) {
+		diffs = append(diffs, fmt.Sprintf("API server ClusterIP changed from %s to %s", ipString(n.APIServer), ipString(other.APIServer)))
+	}
+	if ipString(n.CoreDNS) != ipString(other.CoreDNS) {
+		diffs = append(diffs, fmt.Sprintf("CoreDNS ClusterIP changed from %s to %s", ipString(n.CoreDNS), ipString(other.CoreDNS)))
+	}
+
+	return diffs
+}
+
+// ShootDNSProviderTypes returns the types of all DNS providers configured for the given shoot, i.e. the types of
+// the providers in its DNS specification plus the providers of the given internal and external domains. Unmanaged
+// providers are excluded, and nil DNS specifications or domains are tolerated.
+func ShootDNSProviderTypes(shoot *gardencorev1beta1.Shoot, internalDomain, externalDomain *garden.Domain) sets.String {
+	providerTypes := sets.NewString()
+
+	if shoot.Spec.DNS != nil {
+		for _, provider := range shoot.Spec.DNS.Providers {
+			if provider.Type != nil && *provider.Type != core.DNSUnmanaged {
+				providerTypes.Insert(*provider.Type)
+			}
+		}
+	}
+
+	if internalDomain != nil && internalDomain.Provider != core.DNSUnmanaged {
+		providerTypes.Insert(internalDomain.Provider)
+	}
+
+	if externalDomain != nil && externalDomain.Provider != core.DNSUnmanaged {
+		providerTypes.Insert(externalDomain.Provider)
+	}
+
+	return providerTypes
+}
+
+// ShootContainerRuntimeTypes returns the types of all container runtimes configured in the worker pools of the
+// given shoot. Worker pools without a CRI configuration are tolerated.
+func ShootContainerRuntimeTypes(shoot *gardencorev1beta1.Shoot) sets.String {
+	containerRuntimeTypes := sets.NewString()
+
+	for _, pool := range shoot.Spec.Provider.Workers {
+		if pool.CRI != nil {
+			for _, containerRuntime := range pool.CRI.ContainerRuntimes {
+				containerRuntimeTypes.Insert(containerRuntime.Type)
+			}
+		}
+	}
+
+	return containerRuntimeTypes
+}
+
+// InternalDNSRequired returns true if the internal DNS provider extension is required for the given shoot on
+// the given seed, i.e. if the seed allows shoot DNS and the shoot does not use unmanaged DNS.
+func InternalDNSRequired(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) bool {
+	return seed.Spec.Settings.ShootDNS.Enabled && !gardencorev1beta1helper.ShootUsesUnmanagedDNS(shoot)
+}
+
 // ComputeRequiredExtensions compute the extension kind/type combinations that are required for the
-// reconciliation flow.
-func ComputeRequiredExtensions(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList, internalDomain, externalDomain *garden.Domain, useDNSRecords bool) sets.String {
+// reconciliation flow. If the shoot uses one of the garden's default domains the caller may not have resolved
+// the external domain, in which case the given <defaultDomain> is used to determine the DNS provider.
+func ComputeRequiredExtensions(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList, internalDomain, externalDomain, defaultDomain *garden.Domain, useDNSRecords bool) sets.String {
 	requiredExtensions := sets.NewString()
 
+	if externalDomain == nil {
+		externalDomain = defaultDomain
+	}
+
 	if seed.Spec.Backup != nil {
 		requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.BackupBucketResource, seed.Spec.Backup.Provider))
 		requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.BackupEntryResource, seed.Spec.Backup.Provider))
@@ -566,36 +836,36 @@ func ComputeRequiredExtensions(shoot *gardencorev1beta1.Shoot, seed *gardencorev
 		if pool.Machine.Image != nil {
 			requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.OperatingSystemConfigResource, pool.Machine.Image.Name))
 		}
-		if pool.CRI != nil {
-			for _, cr := range pool.CRI.ContainerRuntimes {
-				requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.ContainerRuntimeResource, cr.Type))
-			}
-		}
+	}
+	for _, containerRuntimeType := range ShootContainerRuntimeTypes(shoot).UnsortedList() {
+		requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType))
 	}
 
 	if seed.Spec.Settings.ShootDNS.Enabled {
-		if shoot.Spec.DNS != nil {
-			for _, provider := range shoot.Spec.DNS.Providers {
-				if provider.Type != nil && *provider.Type != core.DNSUnmanaged {
-					requiredExtensions.Insert(gardenerextensions.Id(dnsv1alpha1.DNSProviderKind, *provider.Type))
-					if provider.Primary != nil && *provider.Primary && useDNSRecords {
+		// With DNSRecords enabled the internal domain is handled via the DNSRecord extension only, so its
+		// provider type does not contribute a DNSProvider extension in that case.
+		dnsProviderTypes := ShootDNSProviderTypes(shoot, internalDomain, externalDomain)
+		if useDNSRecords && internalDomain != nil {
+			dnsProviderTypes = ShootDNSProviderTypes(shoot, nil, externalDomain)
+		}
+		for _, providerType := range dnsProviderTypes.UnsortedList() {
+			requiredExtensions.Insert(gardenerextensions.Id(dnsv1alpha1.DNSProviderKind, providerType))
+		}
+
+		if useDNSRecords {
+			if shoot.Spec.DNS != nil {
+				for _, provider := range shoot.Spec.DNS.Providers {
+					if provider.Type != nil && *provider.Type != core.DNSUnmanaged && provider.Primary != nil && *provider.Primary {
 						requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.DNSRecordResource, *provider.Type))
 					}
 				}
 			}
-		}
 
-		if internalDomain != nil && internalDomain.Provider != core.DNSUnmanaged {
-			if useDNSRecords {
+			if InternalDNSRequired(seed, shoot) && internalDomain != nil && internalDomain.Provider != core.DNSUnmanaged {
 				requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.DNSRecordResource, internalDomain.Provider))
-			} else {
-				requiredExtensions.Insert(gardenerextensions.Id(dnsv1alpha1.DNSProviderKind, internalDomain.Provider))
 			}
-		}
 
-		if externalDomain != nil && externalDomain.Provider != core.DNSUnmanaged {
-			requiredExtensions.Insert(gardenerextensions.Id(dnsv1alpha1.DNSProviderKind, externalDomain.Provider))
-			if useDNSRecords {
+			if externalDomain != nil && externalDomain.Provider != core.DNSUnmanaged {
 				requiredExtensions.Insert(gardenerextensions.Id(extensionsv1alpha1.DNSRecordResource, externalDomain.Provider))
 			}
 		}
@@ -612,3 +882,21 @@ func ComputeRequiredExtensions(shoot *gardencorev1beta1.Shoot, seed *gardencorev
 
 	return requiredExtensions
 }
+
+// ComputeExistingExtensions returns the extension kind/type combinations of the given extension resources,
+// e.g. previously deployed extension CRs in the shoot namespace.
+func ComputeExistingExtensions(objects []extensionsv1alpha1.Object) sets.String {
+	existingExtensions := sets.NewString()
+
+	for _, obj := range objects {
+		existingExtensions.Insert(gardenerextensions.Id(extensionscontroller.UnsafeGuessKind(obj), obj.GetExtensionSpec().GetExtensionType()))
+	}
+
+	return existingExtensions
+}
+
+// ComputeObsoleteExtensions returns the extension kind/type combinations that were previously required but
+// no longer are, i.e. whose extension resources can be cleaned up.
+func ComputeObsoleteExtensions(previous, current sets.String) sets.String {
+	return previous.Difference(current)
+}
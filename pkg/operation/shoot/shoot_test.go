@@ -16,6 +16,7 @@ package shoot_test
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -34,7 +35,9 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -114,7 +117,202 @@ var _ = Describe("shoot", func() {
 				Entry("coreDNS cannot be calculated", func(s *gardencorev1beta1.Shoot) {
 					s.Spec.Networking.Services = pointer.String("10.0.0.0/29")
 				}),
+				Entry("nodes is invalid", func(s *gardencorev1beta1.Shoot) { s.Spec.Networking.Nodes = pointer.String("foo") }),
+				Entry("pods and services overlap", func(s *gardencorev1beta1.Shoot) {
+					s.Spec.Networking.Services = pointer.String("10.0.0.128/25")
+				}),
+				Entry("pods and nodes overlap", func(s *gardencorev1beta1.Shoot) {
+					s.Spec.Networking.Nodes = pointer.String("10.0.0.0/16")
+				}),
+				Entry("services and nodes overlap", func(s *gardencorev1beta1.Shoot) {
+					s.Spec.Networking.Nodes = pointer.String("20.0.0.128/25")
+				}),
 			)
+
+			It("returns correct network with a non-overlapping nodes cidr", func() {
+				shoot.Spec.Networking.Nodes = pointer.String("30.0.0.0/24")
+
+				result, err := ToNetworks(shoot)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).ToNot(BeNil())
+			})
+
+			It("computes the cluster IPs from the first services CIDR if no preference is given", func() {
+				shoot.Spec.Networking.Services = pointer.String("20.0.0.0/24,2001:db8::/64")
+
+				result, err := ToNetworks(shoot)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.APIServer.String()).To(Equal("20.0.0.1"))
+				Expect(result.CoreDNS.String()).To(Equal("20.0.0.10"))
+				Expect(result.Services.String()).To(Equal("20.0.0.0/24"))
+			})
+
+			It("computes the cluster IPs from the services CIDR matching the preferred IP family", func() {
+				shoot.Spec.Networking.Services = pointer.String("20.0.0.0/24,2001:db8::/64")
+
+				result, err := ToNetworksWithPreferredServiceIPFamily(shoot, corev1.IPv6Protocol)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.APIServer.String()).To(Equal("2001:db8::1"))
+				Expect(result.CoreDNS.String()).To(Equal("2001:db8::a"))
+				Expect(result.Services.String()).To(Equal("20.0.0.0/24"))
+			})
+
+			It("falls back to the first services CIDR if no CIDR matches the preferred IP family", func() {
+				result, err := ToNetworksWithPreferredServiceIPFamily(shoot, corev1.IPv6Protocol)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.APIServer.String()).To(Equal("20.0.0.1"))
+				Expect(result.CoreDNS.String()).To(Equal("20.0.0.10"))
+			})
+		})
+
+		Describe("#CoreDNSClusterIP", func() {
+			var s *Shoot
+
+			BeforeEach(func() {
+				s = &Shoot{
+					Info: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Networking: gardencorev1beta1.Networking{
+								Pods:     pointer.String("10.0.0.0/24"),
+								Services: pointer.String("20.0.0.0/24"),
+							},
+						},
+					},
+				}
+			})
+
+			It("should return the CoreDNS cluster IP", func() {
+				ip, err := s.CoreDNSClusterIP()
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ip.String()).To(Equal("20.0.0.10"))
+			})
+
+			It("should fail if the services CIDR is too small", func() {
+				s.Info.Spec.Networking.Services = pointer.String("10.1.0.0/29")
+
+				ip, err := s.CoreDNSClusterIP()
+
+				Expect(err).To(MatchError(ContainSubstring("cannot compute CoreDNS cluster IP")))
+				Expect(ip).To(BeNil())
+			})
+		})
+
+		Describe("#ComputeServiceIP", func() {
+			It("should compute the IP with the given offset", func() {
+				ip, err := ComputeServiceIP("20.0.0.0/24", 10)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ip).To(Equal(net.IP([]byte{20, 0, 0, 10})))
+			})
+
+			It("should fail for an invalid cidr", func() {
+				ip, err := ComputeServiceIP("foo", 1)
+
+				Expect(err).To(MatchError(ContainSubstring("cannot parse service cidr")))
+				Expect(ip).To(BeNil())
+			})
+
+			It("should fail if the subnet is too small for the offset", func() {
+				ip, err := ComputeServiceIP("10.0.0.0/29", 10)
+
+				Expect(err).To(MatchError(ContainSubstring("subnet \"10.0.0.0/29\" too small")))
+				Expect(ip).To(BeNil())
+			})
+
+			It("should fail for a /32 subnet", func() {
+				ip, err := ComputeServiceIP("10.0.0.0/32", 1)
+
+				Expect(err).To(HaveOccurred())
+				Expect(ip).To(BeNil())
+			})
+		})
+
+		Describe("#String", func() {
+			It("should format all fields", func() {
+				networks := &Networks{
+					Pods: &net.IPNet{
+						IP:   []byte{10, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					Services: &net.IPNet{
+						IP:   []byte{20, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					APIServer: []byte{20, 0, 0, 1},
+					CoreDNS:   []byte{20, 0, 0, 10},
+				}
+
+				Expect(networks.String()).To(Equal("pods=10.0.0.0/24 services=20.0.0.0/24 apiserver=20.0.0.1 coredns=20.0.0.10"))
+			})
+
+			It("should handle nil fields gracefully", func() {
+				Expect((&Networks{}).String()).To(Equal("pods=<nil> services=<nil> apiserver=<nil> coredns=<nil>"))
+			})
+		})
+
+		Describe("#Diff", func() {
+			var networks, other *Networks
+
+			BeforeEach(func() {
+				networks = &Networks{
+					Pods: &net.IPNet{
+						IP:   []byte{10, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					Services: &net.IPNet{
+						IP:   []byte{20, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					APIServer: []byte{20, 0, 0, 1},
+					CoreDNS:   []byte{20, 0, 0, 10},
+				}
+				other = &Networks{
+					Pods: &net.IPNet{
+						IP:   []byte{10, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					Services: &net.IPNet{
+						IP:   []byte{20, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+					APIServer: []byte{20, 0, 0, 1},
+					CoreDNS:   []byte{20, 0, 0, 10},
+				}
+			})
+
+			It("should return no differences for identical networks", func() {
+				Expect(networks.Diff(other)).To(BeEmpty())
+			})
+
+			It("should return a difference when the pods network changed", func() {
+				other.Pods = &net.IPNet{
+					IP:   []byte{10, 1, 0, 0},
+					Mask: []byte{255, 255, 0, 0},
+				}
+
+				Expect(networks.Diff(other)).To(ConsistOf("pods network changed from 10.0.0.0/24 to 10.1.0.0/16"))
+			})
+
+			It("should return a difference when the API server ClusterIP shifted", func() {
+				other.APIServer = []byte{20, 0, 0, 2}
+
+				Expect(networks.Diff(other)).To(ConsistOf("API server ClusterIP changed from 20.0.0.1 to 20.0.0.2"))
+			})
+
+			It("should return multiple differences when several fields changed", func() {
+				other.Services = nil
+				other.CoreDNS = nil
+
+				Expect(networks.Diff(other)).To(ConsistOf(
+					"services network changed from 20.0.0.0/24 to <none>",
+					"CoreDNS ClusterIP changed from 20.0.0.10 to <none>",
+				))
+			})
 		})
 
 		Describe("#IPVSEnabled", func() {
@@ -274,6 +472,163 @@ var _ = Describe("shoot", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("returns an error if multiple providers are marked as primary", func() {
+				var (
+					ctx = context.TODO()
+
+					shoot = &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespace,
+						},
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Domain: &domain,
+								Providers: []gardencorev1beta1.DNSProvider{
+									{
+										Type:    &provider,
+										Primary: pointer.Bool(true),
+									},
+									{
+										Type:    pointer.String("other-provider"),
+										Primary: pointer.Bool(true),
+									},
+								},
+							},
+						},
+					}
+				)
+
+				externalDomain, err := ConstructExternalDomain(ctx, c, shoot, nil, nil)
+
+				Expect(externalDomain).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring("only one primary DNS provider is allowed")))
+			})
+
+			It("returns a typed error if the referenced secret is not found", func() {
+				var (
+					ctx = context.TODO()
+
+					dnsSecretName = "my-secret"
+					dnsSecretKey  = kutil.Key(namespace, dnsSecretName)
+
+					shoot = &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespace,
+						},
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Domain: &domain,
+								Providers: []gardencorev1beta1.DNSProvider{
+									{
+										Type:       &provider,
+										SecretName: &dnsSecretName,
+										Primary:    pointer.Bool(true),
+									},
+								},
+							},
+						},
+					}
+				)
+
+				c.EXPECT().Get(ctx, dnsSecretKey, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, dnsSecretName))
+
+				externalDomain, err := ConstructExternalDomain(ctx, c, shoot, nil, nil)
+
+				Expect(externalDomain).To(BeNil())
+				Expect(IsDNSProviderSecretNotFoundError(err)).To(BeTrue())
+			})
+
+			It("returns a generic error if the referenced secret cannot be read", func() {
+				var (
+					ctx = context.TODO()
+
+					dnsSecretName = "my-secret"
+					dnsSecretKey  = kutil.Key(namespace, dnsSecretName)
+
+					shoot = &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespace,
+						},
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Domain: &domain,
+								Providers: []gardencorev1beta1.DNSProvider{
+									{
+										Type:       &provider,
+										SecretName: &dnsSecretName,
+										Primary:    pointer.Bool(true),
+									},
+								},
+							},
+						},
+					}
+				)
+
+				c.EXPECT().Get(ctx, dnsSecretKey, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(fmt.Errorf("fake"))
+
+				externalDomain, err := ConstructExternalDomain(ctx, c, shoot, nil, nil)
+
+				Expect(externalDomain).To(BeNil())
+				Expect(err).To(HaveOccurred())
+				Expect(IsDNSProviderSecretNotFoundError(err)).To(BeFalse())
+			})
+
+			It("returns the default domain secret even if the provider is primary and has no secret name", func() {
+				var (
+					ctx = context.TODO()
+
+					shoot = &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Domain: &domain,
+								Providers: []gardencorev1beta1.DNSProvider{
+									{
+										Type:    &provider,
+										Primary: pointer.Bool(true),
+									},
+								},
+							},
+						},
+					}
+				)
+
+				externalDomain, err := ConstructExternalDomain(ctx, c, shoot, nil, []*garden.Domain{defaultDomain})
+
+				Expect(externalDomain).To(Equal(&garden.Domain{
+					Domain:     domain,
+					Provider:   defaultDomainProvider,
+					SecretData: defaultDomainSecretData,
+				}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error if the primary provider has no secret name and no default domain or shoot secret applies", func() {
+				var (
+					ctx = context.TODO()
+
+					shoot = &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Domain: &domain,
+								Providers: []gardencorev1beta1.DNSProvider{
+									{
+										Type:    &provider,
+										Primary: pointer.Bool(true),
+									},
+								},
+							},
+						},
+					}
+				)
+
+				externalDomain, err := ConstructExternalDomain(ctx, c, shoot, nil, nil)
+
+				Expect(externalDomain).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring("does not reference a secret")))
+			})
+
 			It("returns the shoot secret", func() {
 				var (
 					ctx = context.TODO()
@@ -366,6 +721,250 @@ var _ = Describe("shoot", func() {
 				Expect(s.ComputeOutOfClusterAPIServerAddress("", false)).To(Equal("api." + externalDomain))
 			})
 		})
+
+		Describe("#ComputeAPIServerSANs", func() {
+			seedNamespace := "shoot--foo--bar"
+
+			It("should return all names incl. the external domain for a managed external domain", func() {
+				internalDomain := "internal.foo"
+				externalDomain := "external.foo"
+				s := &Shoot{
+					SeedNamespace:         seedNamespace,
+					InternalClusterDomain: internalDomain,
+					ExternalClusterDomain: &externalDomain,
+					Info:                  &gardencorev1beta1.Shoot{},
+				}
+
+				Expect(s.ComputeAPIServerSANs("1.2.3.4")).To(Equal([]string{
+					v1beta1constants.DeploymentNameKubeAPIServer,
+					v1beta1constants.DeploymentNameKubeAPIServer + "." + seedNamespace + ".svc",
+					"api." + internalDomain,
+					"api." + externalDomain,
+					"1.2.3.4",
+				}))
+			})
+
+			It("should only contain the internal domain if the shoot's external domain is unmanaged", func() {
+				unmanaged := "unmanaged"
+				internalDomain := "internal.foo"
+				s := &Shoot{
+					SeedNamespace:         seedNamespace,
+					InternalClusterDomain: internalDomain,
+					Info: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Providers: []gardencorev1beta1.DNSProvider{
+									{Type: &unmanaged},
+								},
+							},
+						},
+					},
+				}
+
+				Expect(s.ComputeAPIServerSANs("1.2.3.4")).To(Equal([]string{
+					v1beta1constants.DeploymentNameKubeAPIServer,
+					v1beta1constants.DeploymentNameKubeAPIServer + "." + seedNamespace + ".svc",
+					"api." + internalDomain,
+					"1.2.3.4",
+				}))
+			})
+
+			It("should not contain any domains if DNS is disabled", func() {
+				s := &Shoot{
+					SeedNamespace: seedNamespace,
+					DisableDNS:    true,
+				}
+
+				Expect(s.ComputeAPIServerSANs("1.2.3.4")).To(Equal([]string{
+					v1beta1constants.DeploymentNameKubeAPIServer,
+					v1beta1constants.DeploymentNameKubeAPIServer + "." + seedNamespace + ".svc",
+					"1.2.3.4",
+				}))
+			})
+		})
+
+		Describe("#ResolveDomains", func() {
+			var (
+				internalDomain        = &garden.Domain{Domain: "internal.example.com"}
+				internalClusterDomain = "shoot.project.internal.example.com"
+				externalClusterDomain = "shoot.example.com"
+			)
+
+			It("should return no domains as DNS is disabled", func() {
+				s := &Shoot{DisableDNS: true}
+
+				internal, external, err := s.ResolveDomains(internalDomain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(internal).To(BeEmpty())
+				Expect(external).To(BeEmpty())
+			})
+
+			It("should fail if no internal domain is configured", func() {
+				s := &Shoot{Info: &gardencorev1beta1.Shoot{}}
+
+				_, _, err := s.ResolveDomains(nil)
+				Expect(err).To(MatchError(ContainSubstring("internal domain is not configured")))
+			})
+
+			It("should return the external cluster domain for managed DNS", func() {
+				s := &Shoot{
+					InternalClusterDomain: internalClusterDomain,
+					ExternalClusterDomain: &externalClusterDomain,
+					Info:                  &gardencorev1beta1.Shoot{},
+				}
+
+				internal, external, err := s.ResolveDomains(internalDomain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(internal).To(Equal(internalClusterDomain))
+				Expect(external).To(Equal(externalClusterDomain))
+			})
+
+			It("should return the internal cluster domain as external domain for unmanaged DNS", func() {
+				s := &Shoot{
+					InternalClusterDomain: internalClusterDomain,
+					Info: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							DNS: &gardencorev1beta1.DNS{
+								Providers: []gardencorev1beta1.DNSProvider{
+									{Type: pointer.String("unmanaged")},
+								},
+							},
+						},
+					},
+				}
+
+				internal, external, err := s.ResolveDomains(internalDomain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(internal).To(Equal(internalClusterDomain))
+				Expect(external).To(Equal(internalClusterDomain))
+			})
+		})
+	})
+
+	Describe("#ShootDNSProviderTypes", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					DNS: &gardencorev1beta1.DNS{
+						Providers: []gardencorev1beta1.DNSProvider{
+							{Type: pointer.String("provider1")},
+							{Type: pointer.String("provider2")},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the union of shoot and domain provider types", func() {
+			result := ShootDNSProviderTypes(shoot, &garden.Domain{Provider: "internalprovider"}, &garden.Domain{Provider: "externalprovider"})
+			Expect(result).To(Equal(sets.NewString("provider1", "provider2", "internalprovider", "externalprovider")))
+		})
+
+		It("should handle nil DNS and nil domains", func() {
+			shoot.Spec.DNS = nil
+			Expect(ShootDNSProviderTypes(shoot, nil, nil)).To(BeEmpty())
+		})
+
+		It("should exclude unmanaged providers and providers without a type", func() {
+			shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers,
+				gardencorev1beta1.DNSProvider{Type: pointer.String("unmanaged")},
+				gardencorev1beta1.DNSProvider{},
+			)
+
+			result := ShootDNSProviderTypes(shoot, &garden.Domain{Provider: "unmanaged"}, nil)
+			Expect(result).To(Equal(sets.NewString("provider1", "provider2")))
+		})
+
+		It("should deduplicate overlapping provider types", func() {
+			result := ShootDNSProviderTypes(shoot, &garden.Domain{Provider: "provider1"}, &garden.Domain{Provider: "provider2"})
+			Expect(result).To(Equal(sets.NewString("provider1", "provider2")))
+		})
+	})
+
+	Describe("#ShootContainerRuntimeTypes", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								CRI: &gardencorev1beta1.CRI{
+									ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{
+										{Type: "gvisor"},
+										{Type: "kata-containers"},
+									},
+								},
+							},
+							{
+								CRI: &gardencorev1beta1.CRI{
+									ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{
+										{Type: "gvisor"},
+									},
+								},
+							},
+							{},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the deduplicated container runtime types of all worker pools", func() {
+			Expect(ShootContainerRuntimeTypes(shoot)).To(Equal(sets.NewString("gvisor", "kata-containers")))
+		})
+
+		It("should return an empty set if no worker pool configures a CRI", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{}, {}}
+			Expect(ShootContainerRuntimeTypes(shoot)).To(BeEmpty())
+		})
+	})
+
+	Describe("#InternalDNSRequired", func() {
+		var (
+			seed  *gardencorev1beta1.Seed
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{
+						ShootDNS: &gardencorev1beta1.SeedSettingShootDNS{
+							Enabled: true,
+						},
+					},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					DNS: &gardencorev1beta1.DNS{
+						Providers: []gardencorev1beta1.DNSProvider{
+							{Type: pointer.String("some-provider")},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return true if the seed allows shoot DNS", func() {
+			Expect(InternalDNSRequired(seed, shoot)).To(BeTrue())
+		})
+
+		It("should return false if shoot DNS is disabled on the seed", func() {
+			seed.Spec.Settings.ShootDNS.Enabled = false
+
+			Expect(InternalDNSRequired(seed, shoot)).To(BeFalse())
+		})
+
+		It("should return false if the shoot uses unmanaged DNS", func() {
+			shoot.Spec.DNS.Providers[0].Type = pointer.String("unmanaged")
+
+			Expect(InternalDNSRequired(seed, shoot)).To(BeFalse())
+		})
 	})
 
 	Describe("#ComputeRequiredExtensions", func() {
@@ -481,7 +1080,46 @@ var _ = Describe("shoot", func() {
 
 		Context("when not using DNSRecords", func() {
 			It("should compute the correct list of required extensions", func() {
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, false)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, false)
+
+				Expect(result).To(Equal(sets.NewString(
+					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
+					extensions.Id(extensionsv1alpha1.BackupEntryResource, backupProvider),
+					extensions.Id(extensionsv1alpha1.ControlPlaneResource, seedProvider),
+					extensions.Id(extensionsv1alpha1.ControlPlaneResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.InfrastructureResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.NetworkResource, networkingType),
+					extensions.Id(extensionsv1alpha1.WorkerResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.ExtensionResource, extensionType1),
+					extensions.Id(extensionsv1alpha1.OperatingSystemConfigResource, oscType),
+					extensions.Id(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType1),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType2),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType3),
+					extensions.Id(extensionsv1alpha1.ExtensionResource, extensionType2),
+				)))
+			})
+
+			It("should compute the correct list of required extensions (worker pools with different OS and container runtime types)", func() {
+				var (
+					oscType2              = "osctype2"
+					containerRuntimeType2 = "containerruntimetype2"
+				)
+
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+					Machine: gardencorev1beta1.Machine{
+						Image: &gardencorev1beta1.ShootMachineImage{
+							Name: oscType2,
+						},
+					},
+					CRI: &gardencorev1beta1.CRI{
+						ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{
+							{Type: containerRuntimeType2},
+						},
+					},
+				})
+
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, false)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -493,7 +1131,9 @@ var _ = Describe("shoot", func() {
 					extensions.Id(extensionsv1alpha1.WorkerResource, shootProvider),
 					extensions.Id(extensionsv1alpha1.ExtensionResource, extensionType1),
 					extensions.Id(extensionsv1alpha1.OperatingSystemConfigResource, oscType),
+					extensions.Id(extensionsv1alpha1.OperatingSystemConfigResource, oscType2),
 					extensions.Id(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType),
+					extensions.Id(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType2),
 					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType1),
 					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType2),
 					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType3),
@@ -504,7 +1144,7 @@ var _ = Describe("shoot", func() {
 			It("should compute the correct list of required extensions (no seed backup)", func() {
 				seed.Spec.Backup = nil
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, false)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, false)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.ControlPlaneResource, seedProvider),
@@ -525,7 +1165,7 @@ var _ = Describe("shoot", func() {
 			It("should compute the correct list of required extensions (seed disables DNS)", func() {
 				seed.Spec.Settings.ShootDNS.Enabled = false
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, false)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, false)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -548,7 +1188,7 @@ var _ = Describe("shoot", func() {
 					Disabled: pointer.Bool(true),
 				})
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, false)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, false)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -566,11 +1206,34 @@ var _ = Describe("shoot", func() {
 					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType3),
 				)))
 			})
+
+			It("should include the default domain provider if the shoot uses a default domain", func() {
+				defaultDomain := &garden.Domain{Provider: dnsProviderType2}
+
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, nil, defaultDomain, false)
+
+				Expect(result).To(Equal(sets.NewString(
+					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
+					extensions.Id(extensionsv1alpha1.BackupEntryResource, backupProvider),
+					extensions.Id(extensionsv1alpha1.ControlPlaneResource, seedProvider),
+					extensions.Id(extensionsv1alpha1.ControlPlaneResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.InfrastructureResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.NetworkResource, networkingType),
+					extensions.Id(extensionsv1alpha1.WorkerResource, shootProvider),
+					extensions.Id(extensionsv1alpha1.ExtensionResource, extensionType1),
+					extensions.Id(extensionsv1alpha1.OperatingSystemConfigResource, oscType),
+					extensions.Id(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType1),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType2),
+					extensions.Id(dnsv1alpha1.DNSProviderKind, dnsProviderType3),
+					extensions.Id(extensionsv1alpha1.ExtensionResource, extensionType2),
+				)))
+			})
 		})
 
 		Context("when using DNSRecords", func() {
 			It("should compute the correct list of required extensions", func() {
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, true)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, true)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -594,7 +1257,7 @@ var _ = Describe("shoot", func() {
 			It("should compute the correct list of required extensions (no seed backup)", func() {
 				seed.Spec.Backup = nil
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, true)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, true)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.ControlPlaneResource, seedProvider),
@@ -616,7 +1279,7 @@ var _ = Describe("shoot", func() {
 			It("should compute the correct list of required extensions (seed disables DNS)", func() {
 				seed.Spec.Settings.ShootDNS.Enabled = false
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, true)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, true)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -639,7 +1302,7 @@ var _ = Describe("shoot", func() {
 					Disabled: pointer.Bool(true),
 				})
 
-				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, true)
+				result := ComputeRequiredExtensions(shoot, seed, controllerRegistrationList, internalDomain, externalDomain, nil, true)
 
 				Expect(result).To(Equal(sets.NewString(
 					extensions.Id(extensionsv1alpha1.BackupBucketResource, backupProvider),
@@ -660,4 +1323,47 @@ var _ = Describe("shoot", func() {
 			})
 		})
 	})
+
+	Describe("#ComputeExistingExtensions", func() {
+		It("should compute the identifiers of the given extension resources", func() {
+			objects := []extensionsv1alpha1.Object{
+				&extensionsv1alpha1.Infrastructure{Spec: extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"}}},
+				&extensionsv1alpha1.Extension{Spec: extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "bar"}}},
+			}
+
+			Expect(ComputeExistingExtensions(objects)).To(Equal(sets.NewString(
+				extensions.Id(extensionsv1alpha1.InfrastructureResource, "foo"),
+				extensions.Id(extensionsv1alpha1.ExtensionResource, "bar"),
+			)))
+		})
+	})
+
+	Describe("#ComputeObsoleteExtensions", func() {
+		var (
+			idFoo = extensions.Id(extensionsv1alpha1.ExtensionResource, "foo")
+			idBar = extensions.Id(extensionsv1alpha1.ExtensionResource, "bar")
+			idBaz = extensions.Id(extensionsv1alpha1.ExtensionResource, "baz")
+		)
+
+		It("should return the extensions that are no longer required", func() {
+			previous := sets.NewString(idFoo, idBar)
+			current := sets.NewString(idBar, idBaz)
+
+			Expect(ComputeObsoleteExtensions(previous, current)).To(Equal(sets.NewString(idFoo)))
+		})
+
+		It("should return an empty set if all previous extensions are still required", func() {
+			previous := sets.NewString(idFoo, idBar)
+			current := sets.NewString(idFoo, idBar)
+
+			Expect(ComputeObsoleteExtensions(previous, current)).To(BeEmpty())
+		})
+
+		It("should ignore newly added extensions", func() {
+			previous := sets.NewString()
+			current := sets.NewString(idFoo)
+
+			Expect(ComputeObsoleteExtensions(previous, current)).To(BeEmpty())
+		})
+	})
 })
@@ -16,6 +16,7 @@ package shoot
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -162,6 +163,12 @@ type Networks struct {
 	CoreDNS net.IP
 }
 
+// String returns a human-readable representation of the networks, e.g. for logging purposes. Nil fields are
+// printed as "<nil>".
+func (n *Networks) String() string {
+	return fmt.Sprintf("pods=%s services=%s apiserver=%s coredns=%s", n.Pods, n.Services, n.APIServer, n.CoreDNS)
+}
+
 // IncompleteDNSConfigError is a custom error type.
 type IncompleteDNSConfigError struct{}
 
@@ -175,3 +182,21 @@ func IsIncompleteDNSConfigError(err error) bool {
 	_, ok := err.(*IncompleteDNSConfigError)
 	return ok
 }
+
+// DNSProviderSecretNotFoundError is a custom error type indicating that the secret referenced by the primary
+// DNS provider of a shoot does not exist.
+type DNSProviderSecretNotFoundError struct {
+	err error
+}
+
+// Error prints the error message of the DNSProviderSecretNotFoundError error.
+func (e *DNSProviderSecretNotFoundError) Error() string {
+	return e.err.Error()
+}
+
+// IsDNSProviderSecretNotFoundError returns true if the error indicates that the referenced DNS provider secret
+// was not found. Callers can use this to requeue instead of failing permanently.
+func IsDNSProviderSecretNotFoundError(err error) bool {
+	_, ok := err.(*DNSProviderSecretNotFoundError)
+	return ok
+}
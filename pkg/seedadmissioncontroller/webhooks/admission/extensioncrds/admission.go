@@ -44,16 +44,52 @@ const (
 	WebhookPath = "/webhooks/validate-extension-crd-deletion"
 )
 
+// ProtectedResources returns the resources handled by this webhook, i.e. the CRD resources in both API versions as
+// well as all extension resources.
+func ProtectedResources() []metav1.GroupVersionResource {
+	return []metav1.GroupVersionResource{
+		{Group: apiextensionsv1beta1.SchemeGroupVersion.Group, Version: apiextensionsv1beta1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
+		{Group: apiextensionsv1.SchemeGroupVersion.Group, Version: apiextensionsv1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
+
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "backupbuckets"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "backupentries"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "containerruntimes"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "controlplanes"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "dnsrecords"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "extensions"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "infrastructures"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "networks"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "operatingsystemconfigs"},
+		{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "workers"},
+	}
+}
+
+// protectedResources contains the resources handled by this webhook. It is computed once instead of per request since
+// this webhook is in the hot path of every extension resource deletion.
+var protectedResources = func() map[metav1.GroupVersionResource]struct{} {
+	resources := map[metav1.GroupVersionResource]struct{}{}
+	for _, resource := range ProtectedResources() {
+		resources[resource] = struct{}{}
+	}
+	return resources
+}()
+
 // New creates a new webhook handler validating DELETE requests for extension CRDs and extension resources, that are
-// marked for deletion protection (`gardener.cloud/deletion-protected`).
-func New(logger logr.Logger) *handler {
-	return &handler{logger: logger}
+// marked for deletion protection (`gardener.cloud/deletion-protected`). An optional confirmation annotation key can
+// be passed to overwrite the standard `confirmation.gardener.cloud/deletion` annotation.
+func New(logger logr.Logger, confirmationAnnotation ...string) *handler {
+	h := &handler{logger: logger, confirmationAnnotation: gutil.ConfirmationDeletion}
+	if len(confirmationAnnotation) > 0 {
+		h.confirmationAnnotation = confirmationAnnotation[0]
+	}
+	return h
 }
 
 type handler struct {
-	logger  logr.Logger
-	reader  client.Reader
-	decoder *admission.Decoder
+	logger                 logr.Logger
+	reader                 client.Reader
+	decoder                *admission.Decoder
+	confirmationAnnotation string
 }
 
 var _ admission.Handler = &handler{}
@@ -77,23 +113,14 @@ func (h *handler) Handle(ctx context.Context, request admission.Request) admissi
 		return admission.Allowed("operation is not DELETE")
 	}
 
+	// Dry-run deletions are never persisted, so always allow them (e.g. for `kubectl delete --dry-run=server`)
+	// while keeping the protection for real deletions.
+	if request.DryRun != nil && *request.DryRun {
+		return admission.Allowed("request is a dry run")
+	}
+
 	// Ignore all resources other than our expected ones
-	switch request.Resource {
-	case
-		metav1.GroupVersionResource{Group: apiextensionsv1beta1.SchemeGroupVersion.Group, Version: apiextensionsv1beta1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
-		metav1.GroupVersionResource{Group: apiextensionsv1.SchemeGroupVersion.Group, Version: apiextensionsv1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
-
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "backupbuckets"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "backupentries"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "containerruntimes"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "controlplanes"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "dnsrecords"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "extensions"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "infrastructures"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "networks"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "operatingsystemconfigs"},
-		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "workers"}:
-	default:
+	if _, ok := protectedResources[request.Resource]; !ok {
 		return admission.Allowed("resource is not deletion-protected")
 	}
 
@@ -119,7 +146,7 @@ func (h *handler) Handle(ctx context.Context, request admission.Request) admissi
 
 	log.Info("Handling request")
 
-	if err := admitObjectDeletion(log, obj); err != nil {
+	if err := h.admitObjectDeletion(log, obj); err != nil {
 		return admission.Denied(err.Error())
 	}
 	return admission.Allowed("")
@@ -127,18 +154,18 @@ func (h *handler) Handle(ctx context.Context, request admission.Request) admissi
 
 // admitObjectDeletion checks if the object deletion is confirmed. If the given object is a list of objects then it
 // performs the check for every single object.
-func admitObjectDeletion(log logr.Logger, obj runtime.Object) error {
+func (h *handler) admitObjectDeletion(log logr.Logger, obj runtime.Object) error {
 	if strings.HasSuffix(obj.GetObjectKind().GroupVersionKind().Kind, "List") {
 		return meta.EachListItem(obj, func(o runtime.Object) error {
-			return checkIfObjectDeletionIsConfirmed(log, o)
+			return h.checkIfObjectDeletionIsConfirmed(log, o)
 		})
 	}
-	return checkIfObjectDeletionIsConfirmed(log, obj)
+	return h.checkIfObjectDeletionIsConfirmed(log, obj)
 }
 
 // checkIfObjectDeletionIsConfirmed checks if the object was annotated with the deletion confirmation. If it is a custom
 // resource definition then it is only considered if the CRD has the deletion protection label.
-func checkIfObjectDeletionIsConfirmed(log logr.Logger, object runtime.Object) error {
+func (h *handler) checkIfObjectDeletionIsConfirmed(log logr.Logger, object runtime.Object) error {
 	obj, ok := object.(client.Object)
 	if !ok {
 		return fmt.Errorf("%T does not implement client.Object", object)
@@ -146,7 +173,7 @@ func checkIfObjectDeletionIsConfirmed(log logr.Logger, object runtime.Object) er
 
 	log = log.WithValues("name", obj.GetName())
 
-	if err := gutil.CheckIfDeletionIsConfirmed(obj); err != nil {
+	if err := gutil.CheckIfDeletionIsConfirmedWithAnnotation(obj, h.confirmationAnnotation); err != nil {
 		log.Info("Deletion is not confirmed - preventing deletion")
 		return err
 	}
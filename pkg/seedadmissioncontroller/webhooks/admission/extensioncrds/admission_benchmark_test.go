@@ -0,0 +1,75 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensioncrds_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/seedadmissioncontroller/webhooks/admission/extensioncrds"
+	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// BenchmarkHandle measures the allocations per admission request for the common case of a confirmed deletion of a
+// protected extension resource.
+func BenchmarkHandle(b *testing.B) {
+	var (
+		ctx      = context.TODO()
+		handler  = extensioncrds.New(logr.Discard())
+		resource = metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "infrastructures"}
+	)
+
+	decoder, err := admission.NewDecoder(kubernetes.SeedScheme)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := admission.InjectDecoderInto(decoder, handler); err != nil {
+		b.Fatal(err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(resource.Group + "/" + resource.Version)
+	obj.SetKind("Infrastructure")
+	obj.SetName("foo")
+	obj.SetAnnotations(map[string]string{gutil.ConfirmationDeletion: "true"})
+
+	objJSON, err := json.Marshal(obj)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	request := admission.Request{}
+	request.Operation = admissionv1.Delete
+	request.Resource = resource
+	request.OldObject.Raw = objJSON
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if response := handler.Handle(ctx, request); !response.Allowed {
+			b.Fatalf("unexpected response: %v", response)
+		}
+	}
+}
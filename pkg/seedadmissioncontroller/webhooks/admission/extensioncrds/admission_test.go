@@ -170,6 +170,16 @@ var _ = Describe("handler", func() {
 				request.Resource = fooResource
 				expectAllowed(handler.Handle(ctx, request), ContainSubstring("resource is not deletion-protected"))
 			})
+
+			It("should allow dry-run deletions regardless of the confirmation annotation", func() {
+				dryRun := true
+				request.DryRun = &dryRun
+
+				for _, resource := range resources {
+					request.Resource = resource
+					expectAllowed(handler.Handle(ctx, request), ContainSubstring("dry run"), resourceToId(resource))
+				}
+			})
 		})
 
 		Context("old object is set", func() {
@@ -204,6 +214,37 @@ var _ = Describe("handler", func() {
 			})
 		})
 
+		Context("custom confirmation annotation", func() {
+			const customAnnotation = "confirmation.example.com/deletion"
+
+			var obj *unstructured.Unstructured
+
+			BeforeEach(func() {
+				obj = &unstructured.Unstructured{}
+
+				handler = extensioncrds.New(logger, customAnnotation)
+				Expect(inject.APIReaderInto(c, handler)).To(BeTrue())
+				Expect(admission.InjectDecoderInto(decoder, handler)).To(BeTrue())
+			})
+
+			It("should prevent the deletion because only the standard annotation is set", func() {
+				for _, resource := range resources {
+					objJSON := getObjectJSONWithLabelsAnnotations(obj, resource, nil, deletionConfirmedAnnotations)
+					request.OldObject = runtime.RawExtension{Raw: objJSON}
+					request.Resource = resource
+					expectDenied(handler.Handle(ctx, request), ContainSubstring(customAnnotation), resourceToId(resource))
+				}
+			})
+
+			It("should admit the deletion because the custom annotation is set", func() {
+				for _, resource := range resources {
+					objJSON := getObjectJSONWithLabelsAnnotations(obj, resource, nil, map[string]string{customAnnotation: "true"})
+					request.OldObject = runtime.RawExtension{Raw: objJSON}
+					testDeletionConfirmed(ctx, request, resource)
+				}
+			})
+		})
+
 		Context("new object is set", func() {
 			var obj *unstructured.Unstructured
 
@@ -361,6 +402,23 @@ var _ = Describe("handler", func() {
 			})
 		})
 	})
+
+	Describe("#ProtectedResources", func() {
+		It("should contain the CRD resources and all extension resources", func() {
+			protectedResources := extensioncrds.ProtectedResources()
+
+			Expect(protectedResources).To(ContainElements(
+				metav1.GroupVersionResource{Group: apiextensionsv1beta1.SchemeGroupVersion.Group, Version: apiextensionsv1beta1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
+				metav1.GroupVersionResource{Group: apiextensionsv1.SchemeGroupVersion.Group, Version: apiextensionsv1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
+			))
+
+			for _, resource := range []string{"backupbuckets", "backupentries", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "workers"} {
+				Expect(protectedResources).To(ContainElement(
+					metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: resource},
+				), resource)
+			}
+		})
+	})
 })
 
 func expectAllowed(response admission.Response, reason gomegatypes.GomegaMatcher, optionalDescription ...interface{}) {
@@ -261,4 +261,75 @@ var _ = Describe("Flow", func() {
 			Expect(err.(*multierror.Error).Errors).To(ConsistOf(err1, err2))
 		})
 	})
+
+	Describe("#ParallelN", func() {
+		It("should run at most n functions concurrently", func() {
+			var (
+				ctx   = context.TODO()
+				mutex sync.Mutex
+
+				current, max int
+
+				started = make(chan struct{})
+				finish  = make(chan struct{})
+			)
+
+			fn := func(context.Context) error {
+				mutex.Lock()
+				current++
+				if current > max {
+					max = current
+				}
+				mutex.Unlock()
+
+				started <- struct{}{}
+				<-finish
+
+				mutex.Lock()
+				current--
+				mutex.Unlock()
+				return nil
+			}
+
+			done := make(chan error)
+			go func() {
+				done <- flow.ParallelN(2, fn, fn, fn, fn, fn)(ctx)
+			}()
+
+			// Wait until two functions have started to prove they run concurrently, then let all
+			// functions run to completion one after another
+			<-started
+			<-started
+			for i := 0; i < 3; i++ {
+				finish <- struct{}{}
+				<-started
+			}
+			finish <- struct{}{}
+			finish <- struct{}{}
+
+			Expect(<-done).To(Succeed())
+			Expect(max).To(Equal(2))
+		})
+
+		It("should execute the functions and collect their errors", func() {
+			var (
+				ctx = context.TODO()
+				f1  = mockflow.NewMockTaskFn(ctrl)
+				f2  = mockflow.NewMockTaskFn(ctrl)
+				f3  = mockflow.NewMockTaskFn(ctrl)
+
+				err1 = errors.New("e1")
+				err2 = errors.New("e2")
+			)
+
+			f1.EXPECT().Do(ctx).Return(err1)
+			f2.EXPECT().Do(ctx).Return(err2)
+			f3.EXPECT().Do(ctx)
+
+			err := flow.ParallelN(2, f1.Do, f2.Do, f3.Do)(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(&multierror.Error{}))
+			Expect(err.(*multierror.Error).Errors).To(ConsistOf(err1, err2))
+		})
+	})
 })
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TimingProgressReporter is a ProgressReporter that wraps another reporter and additionally records the
+// start and end timestamps of every flow task. When the reporter is stopped it logs a summary of the task
+// durations, slowest tasks first.
+type TimingProgressReporter struct {
+	reporter ProgressReporter
+	log      logrus.FieldLogger
+
+	lock       sync.Mutex
+	startTimes map[TaskID]time.Time
+	durations  map[TaskID]time.Duration
+}
+
+// NewTimingProgressReporter returns a new TimingProgressReporter wrapping the given reporter, which may be
+// nil if only the timing summary is of interest.
+func NewTimingProgressReporter(reporter ProgressReporter, log logrus.FieldLogger) *TimingProgressReporter {
+	return &TimingProgressReporter{
+		reporter:   reporter,
+		log:        log,
+		startTimes: make(map[TaskID]time.Time),
+		durations:  make(map[TaskID]time.Duration),
+	}
+}
+
+// Start starts the wrapped progress reporter.
+func (t *TimingProgressReporter) Start(ctx context.Context) error {
+	if t.reporter != nil {
+		return t.reporter.Start(ctx)
+	}
+	return nil
+}
+
+// Stop stops the wrapped progress reporter and logs the recorded task durations, slowest tasks first.
+func (t *TimingProgressReporter) Stop() {
+	if t.reporter != nil {
+		t.reporter.Stop()
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.durations) == 0 {
+		return
+	}
+
+	type taskDuration struct {
+		id       TaskID
+		duration time.Duration
+	}
+
+	sorted := make([]taskDuration, 0, len(t.durations))
+	for id, duration := range t.durations {
+		sorted = append(sorted, taskDuration{id, duration})
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].duration > sorted[j].duration })
+
+	parts := make([]string, 0, len(sorted))
+	for _, td := range sorted {
+		parts = append(parts, fmt.Sprintf("%s: %s", td.id, td.duration))
+	}
+
+	t.log.Infof("Task durations (slowest first): %s", strings.Join(parts, ", "))
+}
+
+// Report records start and end timestamps based on the task state transitions in the given statistics and
+// forwards them to the wrapped progress reporter.
+func (t *TimingProgressReporter) Report(ctx context.Context, stats *Stats) {
+	now := time.Now().UTC()
+
+	t.lock.Lock()
+	for id := range stats.Running {
+		if _, ok := t.startTimes[id]; !ok {
+			t.startTimes[id] = now
+		}
+	}
+	for id := range stats.Succeeded.Copy().Insert(stats.Failed) {
+		if start, ok := t.startTimes[id]; ok {
+			if _, ok := t.durations[id]; !ok {
+				t.durations[id] = now.Sub(start)
+			}
+		}
+	}
+	t.lock.Unlock()
+
+	if t.reporter != nil {
+		t.reporter.Report(ctx, stats)
+	}
+}
+
+// TaskDurations returns the durations recorded so far for all finished tasks.
+func (t *TimingProgressReporter) TaskDurations() map[TaskID]time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	out := make(map[TaskID]time.Duration, len(t.durations))
+	for id, duration := range t.durations {
+		out[id] = duration
+	}
+	return out
+}
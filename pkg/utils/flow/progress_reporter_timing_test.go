@@ -0,0 +1,82 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProgressReporterTiming", func() {
+	var ctx = context.TODO()
+
+	Describe("#Report", func() {
+		It("should capture a duration for each task of a flow", func() {
+			var (
+				g = flow.NewGraph("test")
+
+				task1 = g.Add(flow.Task{
+					Name: "task1",
+					Fn: func(ctx context.Context) error {
+						time.Sleep(time.Millisecond)
+						return nil
+					},
+				})
+				_ = g.Add(flow.Task{
+					Name: "task2",
+					Fn: func(ctx context.Context) error {
+						time.Sleep(time.Millisecond)
+						return nil
+					},
+					Dependencies: flow.NewTaskIDs(task1),
+				})
+
+				reporter = flow.NewTimingProgressReporter(nil, logger.NewNopLogger())
+				f        = g.Compile()
+			)
+
+			Expect(f.Run(flow.Opts{Context: ctx, ProgressReporter: reporter})).To(Succeed())
+
+			durations := reporter.TaskDurations()
+			Expect(durations).To(HaveLen(2))
+			Expect(durations).To(HaveKey(flow.TaskID("task1")))
+			Expect(durations).To(HaveKey(flow.TaskID("task2")))
+			for _, duration := range durations {
+				Expect(duration).To(BeNumerically(">", 0))
+			}
+		})
+
+		It("should forward the statistics to the wrapped reporter", func() {
+			var (
+				reportedStats []*flow.Stats
+				inner         = flow.NewImmediateProgressReporter(func(_ context.Context, stats *flow.Stats) {
+					reportedStats = append(reportedStats, stats)
+				})
+				reporter = flow.NewTimingProgressReporter(inner, logger.NewNopLogger())
+				stats    = &flow.Stats{FlowName: "foo"}
+			)
+
+			reporter.Report(ctx, stats)
+
+			Expect(reportedStats).To(ConsistOf(stats))
+		})
+	})
+})
@@ -147,6 +147,45 @@ func Parallel(fns ...TaskFn) TaskFn {
 	}
 }
 
+// ParallelN runs the given TaskFns in parallel with at most n concurrent executions, collecting their errors in a
+// multierror. For n <= 0 or n >= len(fns) it behaves like Parallel.
+func ParallelN(n int, fns ...TaskFn) TaskFn {
+	if n <= 0 || n >= len(fns) {
+		return Parallel(fns...)
+	}
+	return func(ctx context.Context) error {
+		var (
+			wg     sync.WaitGroup
+			sem    = make(chan struct{}, n)
+			errors = make(chan error)
+			result error
+		)
+
+		for _, fn := range fns {
+			t := fn
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				errors <- t(ctx)
+			}()
+		}
+
+		go func() {
+			defer close(errors)
+			wg.Wait()
+		}()
+
+		for err := range errors {
+			if err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+		return result
+	}
+}
+
 // ParallelExitOnError runs the given TaskFns in parallel and stops execution as soon as one TaskFn returns an error.
 func ParallelExitOnError(fns ...TaskFn) TaskFn {
 	return func(ctx context.Context) error {
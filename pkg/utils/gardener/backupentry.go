@@ -17,6 +17,8 @@ package gardener
 import (
 	"strings"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -27,6 +29,19 @@ func GenerateBackupEntryName(shootTechnicalID string, shootUID types.UID) string
 	return shootTechnicalID + backupEntryDelimiter + string(shootUID)
 }
 
+// ComputeBackupEntrySeedNames returns the namespace and name in the seed cluster that are relevant for the given
+// core BackupEntry: the namespace is the shoot's technical ID encoded in the BackupEntry name (the control plane
+// namespace in the seed), and the name is the BackupEntry name itself since the extension BackupEntry in the seed
+// shares it. If the BackupEntry is not assigned to any seed (spec.seedName is nil), both return values are empty.
+func ComputeBackupEntrySeedNames(backupEntry *gardencorev1beta1.BackupEntry) (namespace, name string) {
+	if backupEntry.Spec.SeedName == nil {
+		return "", ""
+	}
+
+	shootTechnicalID, _ := ExtractShootDetailsFromBackupEntryName(backupEntry.Name)
+	return shootTechnicalID, backupEntry.Name
+}
+
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
 func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechnicalID string, shootUID types.UID) {
 	tokens := strings.Split(backupEntryName, backupEntryDelimiter)
@@ -15,11 +15,14 @@
 package gardener_test
 
 import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 )
 
 var _ = Describe("BackupEntry", func() {
@@ -42,4 +45,33 @@ var _ = Describe("BackupEntry", func() {
 			Expect(uid).To(Equal(shootUID))
 		})
 	})
+
+	Describe("#ComputeBackupEntrySeedNames", func() {
+		var backupEntry *gardencorev1beta1.BackupEntry
+
+		BeforeEach(func() {
+			backupEntry = &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: backupEntryName,
+				},
+				Spec: gardencorev1beta1.BackupEntrySpec{
+					SeedName: pointer.String("seed"),
+				},
+			}
+		})
+
+		It("should compute the seed namespace and name", func() {
+			namespace, name := ComputeBackupEntrySeedNames(backupEntry)
+			Expect(namespace).To(Equal(shootTechnicalID))
+			Expect(name).To(Equal(backupEntryName))
+		})
+
+		It("should return empty values if no seed is assigned", func() {
+			backupEntry.Spec.SeedName = nil
+
+			namespace, name := ComputeBackupEntrySeedNames(backupEntry)
+			Expect(namespace).To(BeEmpty())
+			Expect(name).To(BeEmpty())
+		})
+	})
 })
@@ -41,14 +41,20 @@ var TimeNow = time.Now
 
 // CheckIfDeletionIsConfirmed returns whether the deletion of an object is confirmed or not.
 func CheckIfDeletionIsConfirmed(obj client.Object) error {
+	return CheckIfDeletionIsConfirmedWithAnnotation(obj, ConfirmationDeletion)
+}
+
+// CheckIfDeletionIsConfirmedWithAnnotation is like CheckIfDeletionIsConfirmed but checks the given annotation key
+// instead of the standard ConfirmationDeletion annotation.
+func CheckIfDeletionIsConfirmedWithAnnotation(obj client.Object, annotation string) error {
 	annotations := obj.GetAnnotations()
 	if annotations == nil {
-		return confirmationAnnotationRequiredError()
+		return confirmationAnnotationRequiredError(annotation)
 	}
 
-	value := annotations[ConfirmationDeletion]
+	value := annotations[annotation]
 	if confirmed, err := strconv.ParseBool(value); err != nil || !confirmed {
-		return confirmationAnnotationRequiredError()
+		return confirmationAnnotationRequiredError(annotation)
 	}
 	return nil
 }
@@ -62,6 +68,6 @@ func ConfirmDeletion(ctx context.Context, w client.Writer, obj client.Object) er
 	return w.Patch(ctx, obj, patch)
 }
 
-func confirmationAnnotationRequiredError() error {
-	return fmt.Errorf("must have a %q annotation to delete", ConfirmationDeletion)
+func confirmationAnnotationRequiredError(annotation string) error {
+	return fmt.Errorf("must have a %q annotation to delete", annotation)
 }
@@ -16,6 +16,8 @@ package gardener
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -65,6 +67,59 @@ func ProjectForNamespaceFromReader(ctx context.Context, reader client.Reader, na
 	return &projectList.Items[0], nil
 }
 
+// ProjectNameResolver resolves the name of the Project responsible for a given namespace. If constructed with a
+// positive TTL it caches resolved names per namespace for that duration so that hot reconcile paths (e.g. controllers
+// reconciling in short, fixed intervals) don't repeatedly list Project objects. On a cache miss or after expiry it
+// falls back to reading via ProjectForNamespaceFromReader.
+type ProjectNameResolver struct {
+	reader client.Reader
+	ttl    time.Duration
+
+	lock  sync.Mutex
+	cache map[string]projectNameCacheEntry
+}
+
+type projectNameCacheEntry struct {
+	projectName string
+	expiresAt   time.Time
+}
+
+// NewProjectNameResolver returns a new ProjectNameResolver that reads via the given reader and caches resolved
+// project names for the given TTL. A non-positive TTL disables caching.
+func NewProjectNameResolver(reader client.Reader, ttl time.Duration) *ProjectNameResolver {
+	return &ProjectNameResolver{
+		reader: reader,
+		ttl:    ttl,
+		cache:  map[string]projectNameCacheEntry{},
+	}
+}
+
+// ProjectName returns the name of the Project responsible for the given namespace, either from the cache (if a
+// non-expired entry exists) or by reading it via the resolver's reader.
+func (r *ProjectNameResolver) ProjectName(ctx context.Context, namespaceName string) (string, error) {
+	if r.ttl > 0 {
+		r.lock.Lock()
+		entry, ok := r.cache[namespaceName]
+		r.lock.Unlock()
+		if ok && TimeNow().Before(entry.expiresAt) {
+			return entry.projectName, nil
+		}
+	}
+
+	project, err := ProjectForNamespaceFromReader(ctx, r.reader, namespaceName)
+	if err != nil {
+		return "", err
+	}
+
+	if r.ttl > 0 {
+		r.lock.Lock()
+		r.cache[namespaceName] = projectNameCacheEntry{projectName: project.Name, expiresAt: TimeNow().Add(r.ttl)}
+		r.lock.Unlock()
+	}
+
+	return project.Name, nil
+}
+
 // ProjectAndNamespaceFromReader returns the Project responsible for a given <namespace>. It reads the namespace and
 // fetches the project name label. Then it will read the project with the respective name.
 func ProjectAndNamespaceFromReader(ctx context.Context, reader client.Reader, namespaceName string) (*gardencorev1beta1.Project, *corev1.Namespace, error) {
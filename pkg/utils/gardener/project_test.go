@@ -17,6 +17,7 @@ package gardener_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -25,6 +26,7 @@ import (
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/test"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -139,6 +141,81 @@ var _ = Describe("Project", func() {
 		})
 	})
 
+	Describe("#ProjectNameResolver", func() {
+		var (
+			now      time.Time
+			resolver *ProjectNameResolver
+
+			expectList = func(times int) {
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), client.MatchingFields{gardencore.ProjectNamespace: namespaceName}).DoAndReturn(func(_ context.Context, list *gardencorev1beta1.ProjectList, _ ...client.ListOption) error {
+					(&gardencorev1beta1.ProjectList{Items: []gardencorev1beta1.Project{*project}}).DeepCopyInto(list)
+					return nil
+				}).Times(times)
+			}
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+		})
+
+		It("should fall back to the reader on cache miss and return the project name", func() {
+			resolver = NewProjectNameResolver(c, time.Minute)
+			expectList(1)
+
+			name, err := resolver.ProjectName(ctx, namespaceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal(projectName))
+		})
+
+		It("should serve subsequent calls from the cache", func() {
+			resolver = NewProjectNameResolver(c, time.Minute)
+			expectList(1)
+
+			for i := 0; i < 3; i++ {
+				name, err := resolver.ProjectName(ctx, namespaceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(projectName))
+			}
+		})
+
+		It("should read again after the TTL expired", func() {
+			defer test.WithVars(&TimeNow, func() time.Time { return now })()
+
+			resolver = NewProjectNameResolver(c, time.Minute)
+			expectList(2)
+
+			name, err := resolver.ProjectName(ctx, namespaceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal(projectName))
+
+			now = now.Add(2 * time.Minute)
+
+			name, err = resolver.ProjectName(ctx, namespaceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal(projectName))
+		})
+
+		It("should not cache when the TTL is non-positive", func() {
+			resolver = NewProjectNameResolver(c, 0)
+			expectList(2)
+
+			for i := 0; i < 2; i++ {
+				name, err := resolver.ProjectName(ctx, namespaceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(projectName))
+			}
+		})
+
+		It("should return the error from the reader", func() {
+			resolver = NewProjectNameResolver(c, time.Minute)
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), client.MatchingFields{gardencore.ProjectNamespace: namespaceName}).Return(fakeErr)
+
+			name, err := resolver.ProjectName(ctx, namespaceName)
+			Expect(err).To(MatchError(fakeErr))
+			Expect(name).To(BeEmpty())
+		})
+	})
+
 	Describe("#ProjectAndNamespaceFromReader", func() {
 		It("should return an error because getting the namespace failed", func() {
 			c.EXPECT().Get(ctx, kutil.Key(namespaceName), gomock.AssignableToTypeOf(&corev1.Namespace{})).Return(fakeErr)
@@ -58,6 +58,26 @@ func IsShootFailed(shoot *v1beta1.Shoot) bool {
 		shoot.Status.Gardener.Version == version.Get().GitVersion
 }
 
+// IsShootInMigration checks if a Shoot's control plane migration to another seed has been started but has not
+// yet been completed successfully.
+func IsShootInMigration(shoot *v1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+
+	return lastOperation != nil &&
+		lastOperation.Type == v1beta1.LastOperationTypeMigrate &&
+		lastOperation.State != v1beta1.LastOperationStateSucceeded
+}
+
+// IsShootRestorePending checks if a Shoot has already been successfully prepared for migration and is now waiting for
+// the restoration on the new seed.
+func IsShootRestorePending(shoot *v1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+
+	return lastOperation != nil &&
+		lastOperation.Type == v1beta1.LastOperationTypeRestore &&
+		lastOperation.State == v1beta1.LastOperationStatePending
+}
+
 // IsNowInEffectiveShootMaintenanceTimeWindow checks if the current time is in the effective
 // maintenance time window of the Shoot.
 func IsNowInEffectiveShootMaintenanceTimeWindow(shoot *v1beta1.Shoot) bool {
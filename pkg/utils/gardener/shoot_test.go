@@ -123,6 +123,86 @@ var _ = Describe("Shoot", func() {
 			BeTrue()),
 	)
 
+	DescribeTable("#IsShootInMigration",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootInMigration(shoot)).To(match)
+		},
+
+		Entry("no last operation",
+			&gardencorev1beta1.Shoot{},
+			BeFalse()),
+		Entry("with processing last operation but not of type migrate",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateProcessing,
+					},
+				},
+			},
+			BeFalse()),
+		Entry("with succeeded migrate last operation",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeMigrate,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			},
+			BeFalse()),
+		Entry("with processing migrate last operation",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeMigrate,
+						State: gardencorev1beta1.LastOperationStateProcessing,
+					},
+				},
+			},
+			BeTrue()),
+	)
+
+	DescribeTable("#IsShootRestorePending",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootRestorePending(shoot)).To(match)
+		},
+
+		Entry("no last operation",
+			&gardencorev1beta1.Shoot{},
+			BeFalse()),
+		Entry("with restore last operation but not in pending state",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeRestore,
+						State: gardencorev1beta1.LastOperationStateProcessing,
+					},
+				},
+			},
+			BeFalse()),
+		Entry("with pending last operation but not of type restore",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeMigrate,
+						State: gardencorev1beta1.LastOperationStatePending,
+					},
+				},
+			},
+			BeFalse()),
+		Entry("with pending restore last operation",
+			&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeRestore,
+						State: gardencorev1beta1.LastOperationStatePending,
+					},
+				},
+			},
+			BeTrue()),
+	)
+
 	DescribeTable("#IsObservedAtLatestGenerationAndSucceeded",
 		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
 			Expect(IsObservedAtLatestGenerationAndSucceeded(shoot)).To(match)
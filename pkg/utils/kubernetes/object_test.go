@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -57,6 +58,46 @@ var _ = Describe("Object", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#ObjectExistsByRef", func() {
+		var (
+			namespace = "some-namespace"
+			ref       = &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "foo",
+			}
+		)
+
+		It("should return true if the object exists", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, gomock.AssignableToTypeOf(&metav1.PartialObjectMetadata{})).Return(nil)
+
+			Expect(ObjectExistsByRef(ctx, c, ref, namespace)).To(BeTrue())
+		})
+
+		It("should return false if the object is not found", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, gomock.AssignableToTypeOf(&metav1.PartialObjectMetadata{})).Return(apierrors.NewNotFound(schema.GroupResource{}, ref.Name))
+
+			exists, err := ObjectExistsByRef(ctx, c, ref, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should return the error if the get fails", func() {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, gomock.AssignableToTypeOf(&metav1.PartialObjectMetadata{})).Return(fakeErr)
+
+			exists, err := ObjectExistsByRef(ctx, c, ref, namespace)
+			Expect(err).To(MatchError(fakeErr))
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should fail for an unparsable apiVersion", func() {
+			invalidRef := &autoscalingv1.CrossVersionObjectReference{APIVersion: "a/b/c", Kind: "Secret", Name: "foo"}
+
+			_, err := ObjectExistsByRef(ctx, c, invalidRef, namespace)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("#DeleteObjects", func() {
 		It("should fail because an object fails to delete", func() {
 			gomock.InOrder(
@@ -16,7 +16,12 @@ package unstructured
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -25,32 +30,100 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 var systemMetadataFields = []string{"ownerReferences", "uid", "resourceVersion", "generation", "selfLink", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "managedFields"}
 
+const (
+	// ContentHashAnnotation is the key of an annotation on objects synced by CreateOrPatchObject that contains a stable
+	// hash of the applied content. It allows skipping the patch when the incoming content is unchanged.
+	ContentHashAnnotation = "gardener.cloud/content-hash"
+
+	// OriginAnnotation is the key of an annotation on synced objects that records where the object was copied from,
+	// e.g. the namespace, name, and resource version of its source in the garden cluster.
+	OriginAnnotation = "resources.gardener.cloud/origin"
+
+	// defaultMaxRetries is the default number of attempts for object operations that are retried on conflicts.
+	defaultMaxRetries = 3
+)
+
+// RetryOptions control how object operations that can run into conflicts, e.g. because of concurrently acting
+// reconcilers, are retried.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of attempts for the operation. If non-positive, it defaults to 3.
+	MaxRetries int
+}
+
+func (o RetryOptions) maxRetries() int {
+	if o.MaxRetries <= 0 {
+		return defaultMaxRetries
+	}
+	return o.MaxRetries
+}
+
+// retryOnConflict calls the given function up to maxRetries times, as long as it returns a conflict error.
+// The last error is returned if all attempts fail.
+func retryOnConflict(maxRetries int, fn func() error) error {
+	var err error
+	for i := 0; i < maxRetries; i++ {
+		if err = fn(); !apierrors.IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// ContentHash computes a stable hash of the given object content, ignoring system metadata fields, namespace, and
+// name. Identical contents always produce the same hash.
+func ContentHash(content map[string]interface{}) (string, error) {
+	raw, err := json.Marshal(filterMetadata(content, add(systemMetadataFields, "namespace", "name")...))
+	if err != nil {
+		return "", err
+	}
+	return utils.ComputeSHA256Hex(raw), nil
+}
+
+// GetOption is an option for GetObject influencing how the GET call is performed.
+type GetOption func(*unstructured.Unstructured)
+
+// WithResourceVersion returns a GetOption that requests the GET call to be performed at the given resource version.
+// "0" allows the object to be served from a cache (lower latency, possibly stale), while "" enforces a quorum read.
+// The resource version is set on the object passed to the client, so that clients supporting reads at a specific
+// resource version can honor it; by default no resource version is requested.
+func WithResourceVersion(resourceVersion string) GetOption {
+	return func(obj *unstructured.Unstructured) {
+		obj.SetResourceVersion(resourceVersion)
+	}
+}
+
 // GetObjectByRef returns the object with the given reference and namespace using the given client.
 // The full content of the object is returned as map[string]interface{}, except for system metadata fields.
 // This function can be combined with runtime.DefaultUnstructuredConverter.FromUnstructured to get the object content
 // as runtime.RawExtension.
-func GetObjectByRef(ctx context.Context, c client.Client, ref *autoscalingv1.CrossVersionObjectReference, namespace string) (map[string]interface{}, error) {
+func GetObjectByRef(ctx context.Context, c client.Client, ref *autoscalingv1.CrossVersionObjectReference, namespace string, opts ...GetOption) (map[string]interface{}, error) {
 	gvk, err := gvkFromCrossVersionObjectReference(ref)
 	if err != nil {
 		return nil, err
 	}
-	return GetObject(ctx, c, gvk, ref.Name, namespace)
+	return GetObject(ctx, c, gvk, ref.Name, namespace, opts...)
 }
 
 // GetObject returns the object with the given GVK, name, and namespace as a map using the given client.
 // The full content of the object is returned as map[string]interface{}, except for system metadata fields.
 // This function can be combined with runtime.DefaultUnstructuredConverter.FromUnstructured to get the object content
 // as runtime.RawExtension.
-func GetObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string) (map[string]interface{}, error) {
+func GetObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, opts ...GetOption) (map[string]interface{}, error) {
 	// Initialize the object
 	key := client.ObjectKey{Namespace: namespace, Name: name}
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
 
+	// Apply the given options to the object before reading it
+	for _, opt := range opts {
+		opt(obj)
+	}
+
 	// Get the object
 	if err := c.Get(ctx, key, obj); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -63,6 +136,20 @@ func GetObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind
 	return filterMetadata(obj.UnstructuredContent(), systemMetadataFields...), nil
 }
 
+// GetObjectWithTimeout is like GetObject but derives a child context with the given timeout for the call, so that
+// callers can bound a single read without plumbing a new context. If the timeout is exceeded, a clear timeout error
+// is returned.
+func GetObjectWithTimeout(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, timeout time.Duration) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	content, err := GetObject(ctx, c, gvk, name, namespace)
+	if err != nil && errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("getting object %q with GVK %s in namespace %q timed out after %s", name, gvk.String(), namespace, timeout)
+	}
+	return content, err
+}
+
 // CreateOrPatchObjectByRef creates or patches the object with the given reference and namespace using the given client.
 // The object is created or patched with the given content, except for system metadata fields.
 // This function can be combined with runtime.DefaultUnstructuredConverter.ToUnstructured to create or update an object
@@ -75,27 +162,163 @@ func CreateOrPatchObjectByRef(ctx context.Context, c client.Client, ref *autosca
 	return CreateOrPatchObject(ctx, c, gvk, ref.Name, namespace, content)
 }
 
+// CreateOrPatchOption is an option for CreateOrPatchObject influencing how the given content is validated before
+// it is applied.
+type CreateOrPatchOption func(*createOrPatchOptions)
+
+type createOrPatchOptions struct {
+	requiredKeys []string
+	origin       string
+}
+
+// WithRequiredKeys returns a CreateOrPatchOption that requires at least one of the given top-level keys to be
+// present in the content, e.g. "data" or "stringData" for Secrets. Content containing none of the keys is rejected
+// with a descriptive error instead of silently producing an empty object.
+func WithRequiredKeys(keys ...string) CreateOrPatchOption {
+	return func(options *createOrPatchOptions) {
+		options.requiredKeys = append(options.requiredKeys, keys...)
+	}
+}
+
+// WithOrigin returns a CreateOrPatchOption that stores the given origin, e.g. the namespace, name, and resource
+// version of the source object, in the OriginAnnotation annotation of the applied object. If the origin changed
+// compared to the existing object, the object is patched even if its content is unchanged.
+func WithOrigin(origin string) CreateOrPatchOption {
+	return func(options *createOrPatchOptions) {
+		options.origin = origin
+	}
+}
+
 // CreateOrPatchObject creates or patches the object with the given GVK, name, and namespace using the given client.
 // The object is created or patched with the given content, except for system metadata fields, namespace, and name.
+// A stable hash of the applied content is stored in the ContentHashAnnotation annotation; if the existing object
+// already carries the hash of the incoming content, the patch is skipped.
 // This function can be combined with runtime.DefaultUnstructuredConverter.ToUnstructured to create or update an object
 // from runtime.RawExtension.
-func CreateOrPatchObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, content map[string]interface{}) error {
+func CreateOrPatchObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, content map[string]interface{}, opts ...CreateOrPatchOption) error {
+	return CreateOrPatchObjectWithRetries(ctx, c, gvk, name, namespace, content, RetryOptions{}, opts...)
+}
+
+// CreateOrPatchObjectWithRetries is like CreateOrPatchObject but retries the operation on conflict errors as
+// specified by the given retry options, e.g. for high-contention sync scenarios that benefit from more than
+// the default number of attempts.
+func CreateOrPatchObjectWithRetries(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, content map[string]interface{}, retryOptions RetryOptions, opts ...CreateOrPatchOption) error {
+	options := &createOrPatchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if len(options.requiredKeys) > 0 {
+		var found bool
+		for _, key := range options.requiredKeys {
+			if _, ok := content[key]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("content of object %q with GVK %s in namespace %q contains none of the required keys %v", name, gvk.String(), namespace, options.requiredKeys)
+		}
+	}
+
+	var contentHash string
+	if content != nil {
+		var err error
+		contentHash, err = ContentHash(content)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Initialize the object
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
 	obj.SetName(name)
 	obj.SetNamespace(namespace)
 
-	// Create or patch the object
-	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, obj, func() error {
-		// Set object content
-		if content != nil {
-			obj.SetUnstructuredContent(mergeObjectContents(obj.UnstructuredContent(),
-				filterMetadata(content, add(systemMetadataFields, "namespace", "name")...)))
+	// Skip the patch if the existing object already has the hash of the incoming content
+	if contentHash != "" {
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+		} else if obj.GetAnnotations()[ContentHashAnnotation] == contentHash &&
+			(options.origin == "" || obj.GetAnnotations()[OriginAnnotation] == options.origin) {
+			return nil
 		}
-		return nil
+	}
+
+	// Create or patch the object
+	return retryOnConflict(retryOptions.maxRetries(), func() error {
+		_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, obj, func() error {
+			// Set object content
+			if content != nil {
+				obj.SetUnstructuredContent(mergeObjectContents(obj.UnstructuredContent(),
+					filterMetadata(content, add(systemMetadataFields, "namespace", "name")...)))
+
+				annotations := obj.GetAnnotations()
+				if annotations == nil {
+					annotations = make(map[string]string, 1)
+				}
+				annotations[ContentHashAnnotation] = contentHash
+				obj.SetAnnotations(annotations)
+			}
+
+			if options.origin != "" {
+				annotations := obj.GetAnnotations()
+				if annotations == nil {
+					annotations = make(map[string]string, 1)
+				}
+				annotations[OriginAnnotation] = options.origin
+				obj.SetAnnotations(annotations)
+			}
+			return nil
+		})
+		return err
 	})
-	return err
+}
+
+// ObjectSpec describes a single object to be applied by CreateOrUpdateObjects.
+type ObjectSpec struct {
+	// GVK is the GroupVersionKind of the object.
+	GVK schema.GroupVersionKind
+	// Name is the name of the object.
+	Name string
+	// Namespace is the namespace of the object.
+	Namespace string
+	// Content is the content of the object, except for system metadata fields, namespace, and name.
+	Content map[string]interface{}
+}
+
+// CreateOrUpdateObjects creates or patches all objects described by the given specs with CreateOrPatchObject.
+// If applying an item fails the remaining items are still applied, and all failures are collected in a
+// multierror naming the failed objects.
+func CreateOrUpdateObjects(ctx context.Context, c client.Client, items []ObjectSpec) error {
+	var result *multierror.Error
+	for _, item := range items {
+		if err := CreateOrPatchObject(ctx, c, item.GVK, item.Name, item.Namespace, item.Content); err != nil {
+			result = multierror.Append(result, fmt.Errorf("could not apply object %q with GVK %s in namespace %q: %w", item.Name, item.GVK.String(), item.Namespace, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// CreateOrPatchObjectSSA creates or updates the object with the given GVK, name, and namespace using server-side
+// apply with the given field manager. The object is applied with the given content, except for system metadata
+// fields, namespace, and name. Unlike CreateOrPatchObject there is no optimistic get-then-patch loop, so concurrent
+// reconcilers cannot run into update conflicts. Server-side apply requires Kubernetes 1.18 or higher.
+func CreateOrPatchObjectSSA(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, content map[string]interface{}, fieldManager string) error {
+	// Initialize the object
+	obj := &unstructured.Unstructured{}
+	if content != nil {
+		obj.SetUnstructuredContent(filterMetadata(content, add(systemMetadataFields, "namespace", "name")...))
+	}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	// Apply the object
+	return c.Patch(ctx, obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
 }
 
 // DeleteObjectByRef deletes the object with the given reference and namespace using the given client.
@@ -109,6 +332,12 @@ func DeleteObjectByRef(ctx context.Context, c client.Client, ref *autoscalingv1.
 
 // DeleteObject deletes the object with the given GVK, name, and namespace using the given client.
 func DeleteObject(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string) error {
+	return DeleteObjectWithRetries(ctx, c, gvk, name, namespace, RetryOptions{})
+}
+
+// DeleteObjectWithRetries is like DeleteObject but retries the operation on conflict errors as specified by
+// the given retry options.
+func DeleteObjectWithRetries(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, retryOptions RetryOptions) error {
 	// Initialize the object
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
@@ -116,7 +345,36 @@ func DeleteObject(ctx context.Context, c client.Client, gvk schema.GroupVersionK
 	obj.SetNamespace(namespace)
 
 	// Delete the object
-	return client.IgnoreNotFound(c.Delete(ctx, obj))
+	return retryOnConflict(retryOptions.maxRetries(), func() error {
+		return client.IgnoreNotFound(c.Delete(ctx, obj))
+	})
+}
+
+// DeleteObjectAndWait deletes the object with the given GVK, name, and namespace using the given client, and then
+// polls until the object is actually gone (e.g. after its finalizers have been removed) or the given timeout is
+// exceeded.
+func DeleteObjectAndWait(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, name, namespace string, timeout time.Duration) error {
+	if err := DeleteObject(ctx, c, gvk, name, namespace); err != nil {
+		return err
+	}
+
+	// Initialize the object
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	// Wait until the object is gone
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return kutil.WaitUntilResourceDeleted(ctx, c, obj, time.Second)
+}
+
+// FilterSystemMetadata returns a copy of the given object content with system metadata fields such as the UID,
+// resource version, and owner references removed. It allows sanitizing the content of an object that was read
+// directly with the client before it is applied to another cluster.
+func FilterSystemMetadata(content map[string]interface{}) map[string]interface{} {
+	return filterMetadata(content, systemMetadataFields...)
 }
 
 func gvkFromCrossVersionObjectReference(ref *autoscalingv1.CrossVersionObjectReference) (schema.GroupVersionKind, error) {
@@ -163,11 +421,16 @@ func filterMetadata(content map[string]interface{}, fields ...string) map[string
 		result[key] = value
 	}
 
-	// Delete specified fields from result
+	// Delete specified fields from a copy of the metadata so that the given content is not modified
 	if metadata, ok := result["metadata"].(map[string]interface{}); ok {
+		filteredMetadata := make(map[string]interface{}, len(metadata))
+		for key, value := range metadata {
+			filteredMetadata[key] = value
+		}
 		for _, field := range fields {
-			delete(metadata, field)
+			delete(filteredMetadata, field)
 		}
+		result["metadata"] = filteredMetadata
 	}
 	return result
 }
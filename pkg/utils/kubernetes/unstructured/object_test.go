@@ -0,0 +1,435 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unstructured_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Object", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		ctx       = context.TODO()
+		name      = "foo"
+		namespace = "bar"
+		gvk       = schema.GroupVersionKind{Group: corev1.GroupName, Version: "v1", Kind: "Secret"}
+		key       = client.ObjectKey{Namespace: namespace, Name: name}
+
+		content     map[string]interface{}
+		contentHash string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		content = map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"data": map[string]interface{}{
+				"foo": "YmFy",
+			},
+		}
+
+		var err error
+		contentHash, err = ContentHash(content)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ContentHash", func() {
+		It("should compute the same hash for identical contents", func() {
+			otherHash, err := ContentHash(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata": map[string]interface{}{
+					"name":      "other-name",
+					"namespace": "other-namespace",
+					"uid":       "123",
+				},
+				"data": map[string]interface{}{
+					"foo": "YmFy",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherHash).To(Equal(contentHash))
+		})
+
+		It("should compute a different hash for different contents", func() {
+			content["data"] = map[string]interface{}{"foo": "Y2hhbmdlZA=="}
+
+			otherHash, err := ContentHash(content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherHash).NotTo(Equal(contentHash))
+		})
+	})
+
+	Describe("#GetObject", func() {
+		It("should not request a specific resource version by default", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					Expect(obj.GetResourceVersion()).To(BeEmpty())
+					obj.SetUnstructuredContent(content)
+					return nil
+				})
+
+			result, err := GetObject(ctx, c, gvk, name, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveKeyWithValue("kind", "Secret"))
+		})
+
+		It("should thread the requested resource version to the client call", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					Expect(obj.GetResourceVersion()).To(Equal("0"))
+					obj.SetUnstructuredContent(content)
+					return nil
+				})
+
+			result, err := GetObject(ctx, c, gvk, name, namespace, WithResourceVersion("0"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveKeyWithValue("kind", "Secret"))
+		})
+	})
+
+	Describe("#GetObjectWithTimeout", func() {
+		It("should return the object content if the client responds in time", func() {
+			c.EXPECT().Get(gomock.Any(), key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.SetUnstructuredContent(content)
+					return nil
+				})
+
+			result, err := GetObjectWithTimeout(ctx, c, gvk, name, namespace, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveKeyWithValue("kind", "Secret"))
+		})
+
+		It("should return a timeout error if the client does not respond in time", func() {
+			c.EXPECT().Get(gomock.Any(), key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(ctx context.Context, _ client.ObjectKey, _ *unstructured.Unstructured) error {
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(time.Second):
+						return nil
+					}
+				})
+
+			result, err := GetObjectWithTimeout(ctx, c, gvk, name, namespace, 10*time.Millisecond)
+			Expect(result).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("timed out after")))
+		})
+	})
+
+	Describe("#CreateOrPatchObject", func() {
+		It("should create the object if it does not exist", func() {
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					Return(apierrors.NewNotFound(schema.GroupResource{}, name)).Times(2),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						Expect(obj.GetName()).To(Equal(name))
+						Expect(obj.GetNamespace()).To(Equal(namespace))
+						Expect(obj.GetAnnotations()).To(HaveKeyWithValue(ContentHashAnnotation, contentHash))
+						return nil
+					}),
+			)
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content)).To(Succeed())
+		})
+
+		It("should not patch the object if the content is unchanged", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.SetAnnotations(map[string]string{ContentHashAnnotation: contentHash})
+					return nil
+				})
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content)).To(Succeed())
+		})
+
+		It("should patch the object if the content changed", func() {
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+						obj.SetAnnotations(map[string]string{ContentHashAnnotation: "outdated"})
+						return nil
+					}).Times(2),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.GetAnnotations()).To(HaveKeyWithValue(ContentHashAnnotation, contentHash))
+						return nil
+					}),
+			)
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content)).To(Succeed())
+		})
+
+		It("should set the origin annotation if an origin is given", func() {
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					Return(apierrors.NewNotFound(schema.GroupResource{}, name)).Times(2),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						Expect(obj.GetAnnotations()).To(HaveKeyWithValue(OriginAnnotation, "garden-foo/foo/1"))
+						return nil
+					}),
+			)
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content, WithOrigin("garden-foo/foo/1"))).To(Succeed())
+		})
+
+		It("should not patch the object if content and origin are unchanged", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.SetAnnotations(map[string]string{
+						ContentHashAnnotation: contentHash,
+						OriginAnnotation:      "garden-foo/foo/1",
+					})
+					return nil
+				})
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content, WithOrigin("garden-foo/foo/1"))).To(Succeed())
+		})
+
+		It("should update the origin annotation if the source resource version changed", func() {
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+						obj.SetAnnotations(map[string]string{
+							ContentHashAnnotation: contentHash,
+							OriginAnnotation:      "garden-foo/foo/1",
+						})
+						return nil
+					}).Times(2),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.GetAnnotations()).To(HaveKeyWithValue(OriginAnnotation, "garden-foo/foo/2"))
+						return nil
+					}),
+			)
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content, WithOrigin("garden-foo/foo/2"))).To(Succeed())
+		})
+
+		It("should fail if the content contains none of the required keys", func() {
+			delete(content, "data")
+
+			err := CreateOrPatchObject(ctx, c, gvk, name, namespace, content, WithRequiredKeys("data", "stringData"))
+			Expect(err).To(MatchError(ContainSubstring("required keys")))
+		})
+
+		It("should apply the content if one of the required keys is present", func() {
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					Return(apierrors.NewNotFound(schema.GroupResource{}, name)).Times(2),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})),
+			)
+
+			Expect(CreateOrPatchObject(ctx, c, gvk, name, namespace, content, WithRequiredKeys("data", "stringData"))).To(Succeed())
+		})
+	})
+
+	Describe("#CreateOrPatchObjectWithRetries", func() {
+		var conflictErr error
+
+		BeforeEach(func() {
+			conflictErr = apierrors.NewConflict(schema.GroupResource{}, name, fmt.Errorf("conflict"))
+		})
+
+		It("should respect the configured number of retries", func() {
+			var attempts int
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, name)).Times(6)
+			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+					attempts++
+					if attempts < 5 {
+						return conflictErr
+					}
+					return nil
+				}).Times(5)
+
+			Expect(CreateOrPatchObjectWithRetries(ctx, c, gvk, name, namespace, content, RetryOptions{MaxRetries: 5})).To(Succeed())
+		})
+
+		It("should fail if all default attempts run into conflicts", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, name)).Times(4)
+			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(conflictErr).Times(3)
+
+			err := CreateOrPatchObject(ctx, c, gvk, name, namespace, content)
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	Describe("#CreateOrUpdateObjects", func() {
+		It("should apply all items and aggregate the error of a failing item", func() {
+			fakeErr := fmt.Errorf("fake")
+			keyFor := func(name string) client.ObjectKey { return client.ObjectKey{Namespace: namespace, Name: name} }
+
+			// "foo1" does not exist yet and is created
+			c.EXPECT().Get(ctx, keyFor("foo1"), gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, "foo1")).Times(2)
+			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					Expect(obj.GetName()).To(Equal("foo1"))
+					return nil
+				})
+
+			// "foo2" exists with outdated content and is patched
+			c.EXPECT().Get(ctx, keyFor("foo2"), gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.SetAnnotations(map[string]string{ContentHashAnnotation: "outdated"})
+					return nil
+				}).Times(2)
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}), gomock.Any()).
+				DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(obj.GetName()).To(Equal("foo2"))
+					return nil
+				})
+
+			// "foo3" cannot be read and fails
+			c.EXPECT().Get(ctx, keyFor("foo3"), gomock.AssignableToTypeOf(&unstructured.Unstructured{})).Return(fakeErr)
+
+			err := CreateOrUpdateObjects(ctx, c, []ObjectSpec{
+				{GVK: gvk, Name: "foo1", Namespace: namespace, Content: content},
+				{GVK: gvk, Name: "foo2", Namespace: namespace, Content: content},
+				{GVK: gvk, Name: "foo3", Namespace: namespace, Content: content},
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo3"))
+			Expect(err.Error()).To(ContainSubstring("fake"))
+		})
+
+		It("should succeed if all items apply successfully", func() {
+			c.EXPECT().Get(ctx, key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *unstructured.Unstructured) error {
+					obj.SetAnnotations(map[string]string{ContentHashAnnotation: contentHash})
+					return nil
+				})
+
+			Expect(CreateOrUpdateObjects(ctx, c, []ObjectSpec{
+				{GVK: gvk, Name: name, Namespace: namespace, Content: content},
+			})).To(Succeed())
+		})
+	})
+
+	Describe("#CreateOrPatchObjectSSA", func() {
+		It("should apply the object with the given field manager", func() {
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}), client.Apply, gomock.Any()).
+				DoAndReturn(func(_ context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+					Expect(obj.GetName()).To(Equal(name))
+					Expect(obj.GetNamespace()).To(Equal(namespace))
+
+					patchOptions := &client.PatchOptions{}
+					patchOptions.ApplyOptions(opts)
+					Expect(patchOptions.FieldManager).To(Equal("test-manager"))
+					Expect(patchOptions.Force).To(PointTo(BeTrue()))
+					return nil
+				})
+
+			Expect(CreateOrPatchObjectSSA(ctx, c, gvk, name, namespace, content, "test-manager")).To(Succeed())
+		})
+
+		It("should fail if the apply patch fails", func() {
+			fakeErr := apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, name, nil)
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}), client.Apply, gomock.Any()).Return(fakeErr)
+
+			Expect(CreateOrPatchObjectSSA(ctx, c, gvk, name, namespace, content, "test-manager")).To(MatchError(fakeErr))
+		})
+	})
+
+	Describe("#DeleteObjectWithRetries", func() {
+		It("should respect the configured number of retries", func() {
+			var attempts int
+			c.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				DoAndReturn(func(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+					attempts++
+					if attempts < 3 {
+						return apierrors.NewConflict(schema.GroupResource{}, name, fmt.Errorf("conflict"))
+					}
+					return nil
+				}).Times(3)
+
+			Expect(DeleteObjectWithRetries(ctx, c, gvk, name, namespace, RetryOptions{MaxRetries: 3})).To(Succeed())
+		})
+
+		It("should fail if all default attempts run into conflicts", func() {
+			c.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+				Return(apierrors.NewConflict(schema.GroupResource{}, name, fmt.Errorf("conflict"))).Times(3)
+
+			err := DeleteObject(ctx, c, gvk, name, namespace)
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	Describe("#DeleteObjectAndWait", func() {
+		It("should succeed once the object is gone after its finalizer has been removed", func() {
+			var polls int
+			gomock.InOrder(
+				c.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{})),
+				c.EXPECT().Get(gomock.Any(), key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, _ *unstructured.Unstructured) error {
+						polls++
+						if polls > 2 {
+							return apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+						}
+						// The object still exists because its finalizer has not yet been removed
+						return nil
+					}).Times(3),
+			)
+
+			Expect(DeleteObjectAndWait(ctx, c, gvk, name, namespace, time.Minute)).To(Succeed())
+		})
+
+		It("should time out if the object is not gone in time", func() {
+			c.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&unstructured.Unstructured{}))
+			c.EXPECT().Get(gomock.Any(), key, gomock.AssignableToTypeOf(&unstructured.Unstructured{})).Return(nil).AnyTimes()
+
+			Expect(DeleteObjectAndWait(ctx, c, gvk, name, namespace, 10*time.Millisecond)).
+				To(MatchError(ContainSubstring("still exists")))
+		})
+	})
+})
@@ -72,6 +72,14 @@ func exponentialBackoff(ctx context.Context, backoff wait.Backoff, condition wai
 	duration := backoff.Duration
 
 	for i := 0; i < backoff.Steps; i++ {
+		// Return promptly if the context has been cancelled, e.g. when the reconciliation was aborted,
+		// instead of retrying until all backoff steps are exhausted.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
 		if ok, err := condition(); err != nil || ok {
 			return err
 		}
@@ -62,6 +62,33 @@ var _ = Describe("#tryUpdate", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(objFromFakeClient).To(Equal(infraObj))
 	})
+
+	It("should return promptly with the context error if the context is cancelled", func() {
+		s := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(s)).NotTo(HaveOccurred())
+		objInFakeClient := newInfraObj()
+
+		c := fake.NewClientBuilder().WithScheme(s).WithObjects(objInFakeClient).Build()
+		infraObj := newInfraObj()
+		transform := func() error {
+			infraObj.GetExtensionStatus().SetState(&runtime.RawExtension{Raw: []byte(`{"name":"someState"}`)})
+			return nil
+		}
+
+		// This manager never stops returning conflicts, so without the cancellation check the
+		// loop would only end once all backoff steps are exhausted.
+		u := &conflictErrManager{
+			conflictsBeforeUpdate: retry.DefaultRetry.Steps + 1,
+			client:                c,
+		}
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		tryUpdateErr := tryUpdate(ctx, retry.DefaultRetry, c, infraObj, u.updateFunc, transform)
+		Expect(tryUpdateErr).To(MatchError(context.Canceled))
+		Expect(u.conflictsOccured).To(BeZero())
+	})
 })
 
 type state struct {
@@ -65,6 +65,7 @@ type DeletionConfirmation struct {
 	shootLister              corelisters.ShootLister
 	shootStateLister         corev1alpha1listers.ShootStateLister
 	projectLister            corelisters.ProjectLister
+	backupEntryLister        corelisters.BackupEntryLister
 	readyFunc                admission.ReadyFunc
 }
 
@@ -98,7 +99,10 @@ func (d *DeletionConfirmation) SetInternalCoreInformerFactory(f coreinformers.Sh
 	projectInformer := f.Core().InternalVersion().Projects()
 	d.projectLister = projectInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, projectInformer.Informer().HasSynced)
+	backupEntryInformer := f.Core().InternalVersion().BackupEntries()
+	d.backupEntryLister = backupEntryInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, projectInformer.Informer().HasSynced, backupEntryInformer.Informer().HasSynced)
 }
 
 // SetExternalCoreInformerFactory sets the external garden core informer factory.
@@ -127,6 +131,9 @@ func (d *DeletionConfirmation) ValidateInitialization() error {
 	if d.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if d.backupEntryLister == nil {
+		return errors.New("missing backupentry lister")
+	}
 	if d.shootStateLister == nil {
 		return errors.New("missing shootState lister")
 	}
@@ -197,6 +204,31 @@ func (d *DeletionConfirmation) Validate(ctx context.Context, a admission.Attribu
 		}
 		checkFunc = gutil.CheckIfDeletionIsConfirmed
 
+	case core.Kind("BackupEntry"):
+		listFunc = func() ([]client.Object, error) {
+			list, err := d.backupEntryLister.BackupEntries(a.GetNamespace()).List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			result := make([]client.Object, 0, len(list))
+			for _, obj := range list {
+				result = append(result, obj)
+			}
+			return result, nil
+		}
+		cacheLookup = func() (client.Object, error) {
+			return d.backupEntryLister.BackupEntries(a.GetNamespace()).Get(a.GetName())
+		}
+		liveLookup = func() (client.Object, error) {
+			return d.gardenCoreClient.Core().BackupEntries(a.GetNamespace()).Get(ctx, a.GetName(), kubernetes.DefaultGetOptions())
+		}
+		checkFunc = func(obj client.Object) error {
+			if forceDeletion, _ := strconv.ParseBool(obj.GetAnnotations()[core.BackupEntryForceDeletion]); forceDeletion {
+				return nil
+			}
+			return gutil.CheckIfDeletionIsConfirmed(obj)
+		}
+
 	case v1alpha1.Kind("ShootState"):
 		listFunc = func() ([]client.Object, error) {
 			list, err := d.shootStateLister.List(labels.Everything())
@@ -41,13 +41,15 @@ import (
 var _ = Describe("deleteconfirmation", func() {
 	Describe("#Admit", func() {
 		var (
-			shoot      core.Shoot
-			project    core.Project
-			shootState v1alpha1.ShootState
+			shoot       core.Shoot
+			project     core.Project
+			shootState  v1alpha1.ShootState
+			backupEntry core.BackupEntry
 
-			shootStore      cache.Store
-			projectStore    cache.Store
-			shootStateStore cache.Store
+			shootStore       cache.Store
+			projectStore     cache.Store
+			shootStateStore  cache.Store
+			backupEntryStore cache.Store
 
 			attrs            admission.Attributes
 			admissionHandler *DeletionConfirmation
@@ -75,6 +77,7 @@ var _ = Describe("deleteconfirmation", func() {
 			shootStore = intCoreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore()
 			projectStore = intCoreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore()
 			shootStateStore = extCoreInformerFactory.Core().V1alpha1().ShootStates().Informer().GetStore()
+			backupEntryStore = intCoreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore()
 
 			shoot = core.Shoot{
 				ObjectMeta: metav1.ObjectMeta{
@@ -93,6 +96,12 @@ var _ = Describe("deleteconfirmation", func() {
 					Namespace: "dummyNs",
 				},
 			}
+			backupEntry = core.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dummy",
+					Namespace: "dummy",
+				},
+			}
 		})
 
 		It("should do nothing because the resource is not Shoot, Project or ShootState", func() {
@@ -437,6 +446,53 @@ var _ = Describe("deleteconfirmation", func() {
 				})
 			})
 		})
+
+		Context("BackupEntry resources", func() {
+			BeforeEach(func() {
+				attrs = admission.NewAttributesRecord(nil, nil, core.Kind("BackupEntry").WithVersion("version"), backupEntry.Namespace, backupEntry.Name, core.Resource("backupentries").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, nil)
+			})
+
+			It("should reject an unconfirmed deletion", func() {
+				Expect(backupEntryStore.Add(&backupEntry)).NotTo(HaveOccurred())
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+			})
+
+			It("should reject for false annotation value", func() {
+				backupEntry.Annotations = map[string]string{
+					gutil.ConfirmationDeletion: "false",
+				}
+				Expect(backupEntryStore.Add(&backupEntry)).NotTo(HaveOccurred())
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+			})
+
+			It("should allow a confirmed deletion", func() {
+				backupEntry.Annotations = map[string]string{
+					gutil.ConfirmationDeletion: "true",
+				}
+				Expect(backupEntryStore.Add(&backupEntry)).NotTo(HaveOccurred())
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow an unconfirmed deletion if force-deletion is set", func() {
+				backupEntry.Annotations = map[string]string{
+					core.BackupEntryForceDeletion: "true",
+				}
+				Expect(backupEntryStore.Add(&backupEntry)).NotTo(HaveOccurred())
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("#Register", func() {